@@ -15,38 +15,38 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/v1/media/user-photo": {
-            "post": {
+        "/v1/cover-letters": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Through this api front-ent can upload user photo and get the link to the media.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This API lists the cover letters of the user, the latest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "MEDIA"
+                    "COVER-LETTER"
                 ],
-                "summary": "Upload User photo",
+                "summary": "List Cover Letters",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Image",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.CoverLetterList"
                         }
                     },
                     "400": {
@@ -55,6 +55,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -62,16 +68,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/resume/basic": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for post basic resume",
+                "description": "This API prints a cover letter with the basics of a resume and the letter layout of meta.template, then stores it with the resumes of the user",
                 "consumes": [
                     "application/json"
                 ],
@@ -79,25 +83,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "STEP-RESUME"
+                    "COVER-LETTER"
                 ],
-                "summary": "BASIC RESUME",
+                "summary": "Generate a Cover Letter",
                 "parameters": [
                     {
-                        "description": "BasicResumeData",
-                        "name": "BasicResumeData",
+                        "description": "Cover letter",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Basics"
+                            "$ref": "#/definitions/models.CoverLetter"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the greeting and the closing when meta.lang is empty, English by default",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterRes"
+                            "$ref": "#/definitions/models.CoverLetterRes"
                         }
                     },
                     "400": {
@@ -106,6 +116,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -115,46 +131,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/resume/generate": {
-            "post": {
+        "/v1/cover-letters/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This API for generate a resume",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This API returns a cover letter of the user with the letter it was generated from",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "STEP-RESUME"
+                    "COVER-LETTER"
                 ],
-                "summary": "Generate a Resume",
+                "summary": "Get a Cover Letter",
                 "parameters": [
                     {
-                        "description": "Resume Model",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.LastResumeReq"
-                        }
+                        "type": "string",
+                        "description": "Cover letter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Resume URL",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Error"
+                            "$ref": "#/definitions/models.CoverLetterRes"
                         }
                     },
                     "401": {
@@ -169,6 +174,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.Error"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -176,48 +187,35 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/resume/generate-resume": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This API for generate a resume",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This API deletes a cover letter of the user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "RESUME"
+                    "COVER-LETTER"
                 ],
-                "summary": "Generate a Resume",
+                "summary": "Delete a Cover Letter",
                 "parameters": [
                     {
-                        "description": "Resume Model",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.ResumeGenetare"
-                        }
+                        "type": "string",
+                        "description": "Cover letter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ResumeResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.Error"
+                            "$ref": "#/definitions/models.RegisterRes"
                         }
                     },
                     "401": {
@@ -232,6 +230,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.Error"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -241,83 +245,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/resume/list": {
+        "/v1/fonts/{file}": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Api for ListREsume",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This API serves the fonts embedded in the service, HTML previews load them from here",
                 "produces": [
-                    "application/json"
+                    "font/woff2",
+                    "font/ttf"
                 ],
                 "tags": [
-                    "RESUME"
+                    "TEMPLATE"
                 ],
-                "summary": "LIST RESUME",
+                "summary": "Get a Bundled Font",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "country",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "experience",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "example": "offline",
-                        "name": "job_location",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "job_title",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "example": "full-time",
-                        "name": "job_type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "salary",
-                        "in": "query"
+                        "description": "Font file",
+                        "name": "file",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ResResumeList"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.Error"
+                            "type": "file"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.Error"
                         }
@@ -325,14 +281,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/resume/main": {
+        "/v1/media/user-photo": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for post Main resume",
+                "description": "Through this api front-ent can upload user photo and get the link to the media. The photo is checked from its content, turned upright, stripped of its EXIF metadata and cropped into square JPEG variants, the thumbnail becomes the photo of the user.",
                 "consumes": [
                     "application/json"
                 ],
@@ -340,25 +296,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "STEP-RESUME"
+                    "MEDIA"
                 ],
-                "summary": "Main RESUME",
+                "summary": "Upload User photo",
                 "parameters": [
                     {
-                        "description": "MainResumeData",
-                        "name": "MainResumeData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.MainResumeReq"
-                        }
+                        "type": "file",
+                        "description": "JPEG, PNG or WebP image",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterRes"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -376,14 +330,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/resume/resume-photo": {
+        "/v1/resume/basic": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Through this api front-ent can upload resume photo and get the link to the resume.",
+                "description": "Api for post basic resume",
                 "consumes": [
                     "application/json"
                 ],
@@ -391,23 +345,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MEDIA"
+                    "STEP-RESUME"
                 ],
-                "summary": "Upload Resume Photo",
+                "summary": "BASIC RESUME",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Image",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "description": "BasicResumeData",
+                        "name": "BasicResumeData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Basics"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseUrl"
+                            "$ref": "#/definitions/models.RegisterRes"
                         }
                     },
                     "400": {
@@ -425,14 +381,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/resumes/{id}": {
-            "delete": {
+        "/v1/resume/generate": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Delete Resume",
+                "description": "This API for generate a resume. When several output formats are requested it returns models.ResumeResponse with the URL of each file",
                 "consumes": [
                     "application/json"
                 ],
@@ -440,23 +396,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RESUME"
+                    "STEP-RESUME"
                 ],
-                "summary": "DELETE",
+                "summary": "Generate a Resume",
                 "parameters": [
+                    {
+                        "description": "Resume Model",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LastResumeReq"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "Comma separated output formats: pdf (default), docx, txt or md. The first one is stored with the resume",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Prints a draft: draft, confidential or a custom text of up to 40 characters across every page, with the generation time in the footer. Only for PDF, drafts are never broadcast",
+                        "name": "watermark",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the labels when meta.lang is empty, English by default",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Resume URL",
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterRes"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -465,6 +441,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -474,14 +462,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/token/{refresh}": {
-            "get": {
+        "/v1/resume/generate-resume": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for updated access token",
+                "description": "This API for generate a resume",
                 "consumes": [
                     "application/json"
                 ],
@@ -489,23 +477,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "TOKEN"
+                    "RESUME"
                 ],
-                "summary": "UPDATE TOKEN",
+                "summary": "Generate a Resume",
                 "parameters": [
+                    {
+                        "description": "Resume Model",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ResumeGenetare"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "Refresh Token",
-                        "name": "refresh",
-                        "in": "path",
-                        "required": true
+                        "description": "Comma separated output formats: pdf (default), docx, txt or md. The first one is stored with the resume",
+                        "name": "output",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Prints a draft: draft, confidential or a custom text of up to 40 characters across every page, with the generation time in the footer. Only for PDF, drafts are never broadcast",
+                        "name": "watermark",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the labels when meta.lang is empty, English by default",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.TokenResp"
+                            "$ref": "#/definitions/models.ResumeResponse"
                         }
                     },
                     "400": {
@@ -514,6 +522,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -523,14 +543,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users": {
-            "put": {
+        "/v1/resume/import": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Update",
+                "description": "This API validates a JSON Resume v1 document (jsonresume.org) and maps it onto a resume ready for generation",
                 "consumes": [
                     "application/json"
                 ],
@@ -538,17 +558,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "RESUME"
                 ],
-                "summary": "UPDATE",
+                "summary": "Import a JSON Resume",
                 "parameters": [
                     {
-                        "description": "createModel",
-                        "name": "User",
+                        "description": "JSON Resume document",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UserUpdateReq"
+                            "$ref": "#/definitions/jsonresume.Document"
                         }
                     }
                 ],
@@ -556,11 +576,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserRes"
+                            "$ref": "#/definitions/models.Resume"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/models.Error"
                         }
@@ -572,40 +598,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/resume/import/linkedin": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Create",
+                "description": "This API reads the ZIP archive of a LinkedIn data export and returns a resume draft for the step wizard with the rows that could not be mapped",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "STEP-RESUME"
                 ],
-                "summary": "CREATE",
+                "summary": "Import a LinkedIn data export",
                 "parameters": [
                     {
-                        "description": "createModel",
-                        "name": "User",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UserReq"
-                        }
+                        "type": "file",
+                        "description": "LinkedIn export archive",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserResCreate"
+                            "$ref": "#/definitions/models.LinkedInImportRes"
                         }
                     },
                     "400": {
@@ -614,6 +640,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -623,14 +661,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/code": {
+        "/v1/resume/list": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for verify new password code",
+                "description": "Api for ListREsume",
                 "consumes": [
                     "application/json"
                 ],
@@ -638,18 +676,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SET-PASSWORD"
+                    "RESUME"
                 ],
-                "summary": "FORGET PASSWORD CODE",
+                "summary": "LIST RESUME",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "name": "code",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "experience",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "email",
+                        "example": "offline",
+                        "name": "job_location",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "job_title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "full-time",
+                        "name": "job_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "salary",
                         "in": "query"
                     }
                 ],
@@ -657,7 +727,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterRes"
+                            "$ref": "#/definitions/models.ResResumeList"
                         }
                     },
                     "400": {
@@ -675,14 +745,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/list": {
-            "get": {
+        "/v1/resume/main": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for ListUsers",
+                "description": "Api for post Main resume",
                 "consumes": [
                     "application/json"
                 ],
@@ -690,26 +760,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "STEP-RESUME"
                 ],
-                "summary": "LIST USERS",
+                "summary": "Main RESUME",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "description": "MainResumeData",
+                        "name": "MainResumeData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MainResumeReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Users"
+                            "$ref": "#/definitions/models.RegisterRes"
                         }
                     },
                     "400": {
@@ -727,40 +796,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/login": {
+        "/v1/resume/preview": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for login user",
+                "description": "This API renders a resume to HTML, or to a PNG of its first page, without generating a PDF or storing anything. It is rate limited per user",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "text/html",
+                    "image/png"
                 ],
                 "tags": [
-                    "LOGIN"
+                    "RESUME"
                 ],
-                "summary": "LOGIN",
+                "summary": "Preview a Resume",
                 "parameters": [
                     {
-                        "description": "Login",
-                        "name": "User",
+                        "description": "Resume Model",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Login"
+                            "$ref": "#/definitions/models.ResumeGenetare"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preview format: html (default) or png",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the labels when meta.lang is empty, English by default",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Rendered resume",
                         "schema": {
-                            "$ref": "#/definitions/models.UserResCreate"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -769,6 +851,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.Error"
                         }
                     },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -778,14 +866,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/password": {
-            "put": {
+        "/v1/resume/resume-photo": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for update new password",
+                "description": "Through this api front-ent can upload resume photo and get the link to the resume. The photo is checked from its content, turned upright, stripped of its EXIF metadata and cropped into square JPEG variants, minio_url is the one printed on resumes.",
                 "consumes": [
                     "application/json"
                 ],
@@ -793,26 +881,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SET-PASSWORD"
+                    "MEDIA"
                 ],
-                "summary": "SET NEW PASSWORD",
+                "summary": "Upload Resume Photo",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "email",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "password",
-                        "in": "query"
+                        "type": "file",
+                        "description": "JPEG, PNG or WebP image",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserResCreate"
+                            "$ref": "#/definitions/models.ResponseUrl"
                         }
                     },
                     "400": {
@@ -830,14 +915,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/register": {
-            "post": {
+        "/v1/resumes/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for register a new user",
+                "description": "Api for Delete Resume",
                 "consumes": [
                     "application/json"
                 ],
@@ -845,18 +930,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SIGNUP"
+                    "RESUME"
                 ],
-                "summary": "REGISTER USER",
+                "summary": "DELETE",
                 "parameters": [
                     {
-                        "description": "RegisterUser",
-                        "name": "User",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.RegisterReq"
-                        }
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -881,48 +964,148 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/resume/list": {
+        "/v1/resumes/{id}/export": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for ListUsersResume",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This API returns a stored resume of the user as a JSON Resume v1 document",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "RESUME"
                 ],
-                "summary": "LIST USER RESUME",
+                "summary": "Export a resume as JSON Resume",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jsonresume.Document"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/templates": {
+            "get": {
+                "security": [
                     {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "BearerAuth": []
                     }
                 ],
+                "description": "This API lists the resume templates with the sections, languages and paper sizes they support, custom templates of the user included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TEMPLATE"
+                ],
+                "summary": "List Resume Templates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ResResume"
-                            }
+                            "$ref": "#/definitions/models.TemplateList"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This API stores a ZIP archive of gohtml partials and CSS as a template only its owner can select in meta.template. The entry file is named _\u003cname\u003e.gohtml, partials are included by file name and resources must be embedded as data URIs",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TEMPLATE"
+                ],
+                "summary": "Upload a Custom Template",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Template archive",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Display name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Description",
+                        "name": "description",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Template"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ValidationError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
                         "schema": {
                             "$ref": "#/definitions/models.Error"
                         }
@@ -936,14 +1119,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/set/{email}": {
+        "/v1/templates/{name}/preview": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for set new password",
+                "description": "This API returns the preview image of a resume template",
+                "produces": [
+                    "image/svg+xml",
+                    "image/png"
+                ],
+                "tags": [
+                    "TEMPLATE"
+                ],
+                "summary": "Get Template Preview",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/token/{refresh}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for updated access token",
                 "consumes": [
                     "application/json"
                 ],
@@ -951,15 +1181,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SET-PASSWORD"
+                    "TOKEN"
                 ],
-                "summary": "FORGET PASSWORD",
+                "summary": "UPDATE TOKEN",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "EMAIL",
-                        "name": "email",
-                        "in": "query",
+                        "description": "Refresh Token",
+                        "name": "refresh",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -967,7 +1197,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterRes"
+                            "$ref": "#/definitions/models.TokenResp"
                         }
                     },
                     "400": {
@@ -985,14 +1215,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/token": {
-            "get": {
+        "/v1/users": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Get user by token",
+                "description": "Api for Update",
                 "consumes": [
                     "application/json"
                 ],
@@ -1002,7 +1232,18 @@ const docTemplate = `{
                 "tags": [
                     "USER"
                 ],
-                "summary": "GET BY TOKEN",
+                "summary": "UPDATE",
+                "parameters": [
+                    {
+                        "description": "createModel",
+                        "name": "User",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UserUpdateReq"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1023,16 +1264,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/users/verify": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for verify a new user",
+                "description": "Api for Create",
                 "consumes": [
                     "application/json"
                 ],
@@ -1040,19 +1279,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SIGNUP"
+                    "USER"
                 ],
-                "summary": "VERIFICATION",
+                "summary": "CREATE",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "code",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "email",
-                        "in": "query"
+                        "description": "createModel",
+                        "name": "User",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UserReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -1077,14 +1315,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/{id}": {
+        "/v1/users/code": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Get",
+                "description": "Api for verify new password code",
                 "consumes": [
                     "application/json"
                 ],
@@ -1092,23 +1330,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "SET-PASSWORD"
                 ],
-                "summary": "GET",
+                "summary": "FORGET PASSWORD CODE",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "email",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserRes"
+                            "$ref": "#/definitions/models.RegisterRes"
                         }
                     },
                     "400": {
@@ -1124,14 +1365,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/users/list": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Delete",
+                "description": "Api for ListUsers",
                 "consumes": [
                     "application/json"
                 ],
@@ -1141,21 +1384,24 @@ const docTemplate = `{
                 "tags": [
                     "USER"
                 ],
-                "summary": "DELETE",
+                "summary": "LIST USERS",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterRes"
+                            "$ref": "#/definitions/models.Users"
                         }
                     },
                     "400": {
@@ -1172,9 +1418,989 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
+        },
+        "/v1/users/login": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for login user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LOGIN"
+                ],
+                "summary": "LOGIN",
+                "parameters": [
+                    {
+                        "description": "Login",
+                        "name": "User",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Login"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserResCreate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/password": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for update new password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SET-PASSWORD"
+                ],
+                "summary": "SET NEW PASSWORD",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "password",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserResCreate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/register": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for register a new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SIGNUP"
+                ],
+                "summary": "REGISTER USER",
+                "parameters": [
+                    {
+                        "description": "RegisterUser",
+                        "name": "User",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/resume/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for ListUsersResume",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RESUME"
+                ],
+                "summary": "LIST USER RESUME",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ResResume"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/set/{email}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for set new password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SET-PASSWORD"
+                ],
+                "summary": "FORGET PASSWORD",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "EMAIL",
+                        "name": "email",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/token": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Get user by token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "USER"
+                ],
+                "summary": "GET BY TOKEN",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/verify": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for verify a new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SIGNUP"
+                ],
+                "summary": "VERIFICATION",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "email",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserResCreate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Get",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "USER"
+                ],
+                "summary": "GET",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Delete",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "USER"
+                ],
+                "summary": "DELETE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "jsonresume.Award": {
+            "type": "object",
+            "properties": {
+                "awarder": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Basics": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "experience_year": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "job_location": {
+                    "type": "string"
+                },
+                "job_type": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/jsonresume.Location"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "profiles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Profile"
+                    }
+                },
+                "salary": {
+                    "type": "integer"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Certificate": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "issuer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.CustomEntry": {
+            "type": "object",
+            "properties": {
+                "endDate": {
+                    "type": "string"
+                },
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "location": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "subtitle": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.CustomSection": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.CustomEntry"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Document": {
+            "type": "object",
+            "properties": {
+                "$schema": {
+                    "type": "string"
+                },
+                "awards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Award"
+                    }
+                },
+                "basics": {
+                    "$ref": "#/definitions/jsonresume.Basics"
+                },
+                "certificates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Certificate"
+                    }
+                },
+                "education": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Education"
+                    }
+                },
+                "interests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Interest"
+                    }
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Language"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/jsonresume.Meta"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Project"
+                    }
+                },
+                "publications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Publication"
+                    }
+                },
+                "references": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Reference"
+                    }
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Skill"
+                    }
+                },
+                "volunteer": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Volunteer"
+                    }
+                },
+                "work": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Work"
+                    }
+                }
+            }
+        },
+        "jsonresume.Education": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "type": "string"
+                },
+                "courses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "institution": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "studyType": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Interest": {
+            "type": "object",
+            "properties": {
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Language": {
+            "type": "object",
+            "properties": {
+                "fluency": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Location": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "countryCode": {
+                    "type": "string"
+                },
+                "postalCode": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Meta": {
+            "type": "object",
+            "properties": {
+                "canonical": {
+                    "type": "string"
+                },
+                "customSections": {
+                    "description": "CustomSections have no counterpart in the schema, they are kept here to survive a round trip",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.CustomSection"
+                    }
+                },
+                "lang": {
+                    "type": "string"
+                },
+                "lastModified": {
+                    "type": "string"
+                },
+                "layout": {
+                    "$ref": "#/definitions/models.SectionLayout"
+                },
+                "page": {
+                    "$ref": "#/definitions/models.PageLayout"
+                },
+                "pdf": {
+                    "$ref": "#/definitions/models.PDFOptions"
+                },
+                "qr": {
+                    "$ref": "#/definitions/models.QROptions"
+                },
+                "softSkills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jsonresume.Skill"
+                    }
+                },
+                "template": {
+                    "type": "string"
+                },
+                "theme": {
+                    "$ref": "#/definitions/models.Theme"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Profile": {
+            "type": "object",
+            "properties": {
+                "network": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Project": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "entity": {
+                    "type": "string"
+                },
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Publication": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "publisher": {
+                    "type": "string"
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Reference": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Skill": {
+            "type": "object",
+            "properties": {
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "level": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Volunteer": {
+            "type": "object",
+            "properties": {
+                "endDate": {
+                    "type": "string"
+                },
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "organization": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "jsonresume.Work": {
+            "type": "object",
+            "properties": {
+                "contract_type": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Award": {
+            "type": "object",
+            "properties": {
+                "awarder": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
         "models.Basics": {
             "type": "object",
             "properties": {
@@ -1243,6 +2469,130 @@ const docTemplate = `{
                 }
             }
         },
+        "models.CoverLetter": {
+            "type": "object",
+            "properties": {
+                "basics": {
+                    "$ref": "#/definitions/models.Basics"
+                },
+                "company": {
+                    "type": "string",
+                    "example": "Acme Inc."
+                },
+                "date": {
+                    "description": "Date is written on the letter as YYYY-MM-DD, the day it is generated when empty",
+                    "type": "string",
+                    "example": "2024-06-15"
+                },
+                "meta": {
+                    "$ref": "#/definitions/models.Meta"
+                },
+                "paragraphs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "recipient": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                }
+            }
+        },
+        "models.CoverLetterList": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "cover_letters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CoverLetterRes"
+                    }
+                }
+            }
+        },
+        "models.CoverLetterRes": {
+            "type": "object",
+            "properties": {
+                "company": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "letter": {
+                    "$ref": "#/definitions/models.CoverLetter"
+                },
+                "recipient": {
+                    "type": "string"
+                },
+                "template": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CustomEntry": {
+            "type": "object",
+            "properties": {
+                "endDate": {
+                    "type": "string"
+                },
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Berlin"
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2023-06"
+                },
+                "subtitle": {
+                    "type": "string",
+                    "example": "GopherCon EU"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Scaling Go services"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CustomSection": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CustomEntry"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Talks"
+                }
+            }
+        },
         "models.Education": {
             "type": "object",
             "properties": {
@@ -1272,6 +2622,9 @@ const docTemplate = `{
                 },
                 "studyType": {
                     "type": "string"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
@@ -1283,6 +2636,20 @@ const docTemplate = `{
                 }
             }
         },
+        "models.ImportIssue": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "type": "string"
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
         "models.Interest": {
             "type": "object",
             "properties": {
@@ -1311,6 +2678,12 @@ const docTemplate = `{
         "models.LastResumeReq": {
             "type": "object",
             "properties": {
+                "awards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Award"
+                    }
+                },
                 "basic_redis_id": {
                     "type": "string"
                 },
@@ -1320,6 +2693,13 @@ const docTemplate = `{
                         "$ref": "#/definitions/models.Certificate"
                     }
                 },
+                "customSections": {
+                    "description": "CustomSections are the sections titled by the user",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CustomSection"
+                    }
+                },
                 "interests": {
                     "type": "array",
                     "items": {
@@ -1338,6 +2718,18 @@ const docTemplate = `{
                 "meta": {
                     "$ref": "#/definitions/models.Meta"
                 },
+                "publications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Publication"
+                    }
+                },
+                "references": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Reference"
+                    }
+                },
                 "skills": {
                     "type": "array",
                     "items": {
@@ -1346,15 +2738,35 @@ const docTemplate = `{
                 }
             }
         },
+        "models.LinkedInImportRes": {
+            "type": "object",
+            "properties": {
+                "resume": {
+                    "$ref": "#/definitions/models.Resume"
+                },
+                "unmapped": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImportIssue"
+                    }
+                }
+            }
+        },
         "models.Location": {
             "type": "object",
             "properties": {
+                "address": {
+                    "type": "string"
+                },
                 "city": {
                     "type": "string"
                 },
                 "countryCode": {
                     "type": "string"
                 },
+                "postalCode": {
+                    "type": "string"
+                },
                 "region": {
                     "type": "string"
                 }
@@ -1392,6 +2804,12 @@ const docTemplate = `{
                         "$ref": "#/definitions/models.Project"
                     }
                 },
+                "volunteer": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Volunteer"
+                    }
+                },
                 "work": {
                     "type": "array",
                     "items": {
@@ -1403,11 +2821,89 @@ const docTemplate = `{
         "models.Meta": {
             "type": "object",
             "properties": {
+                "canonical": {
+                    "description": "Canonical, Version and LastModified come from imported JSON Resume documents",
+                    "type": "string"
+                },
                 "lang": {
                     "type": "string"
                 },
+                "lastModified": {
+                    "type": "string"
+                },
+                "layout": {
+                    "$ref": "#/definitions/models.SectionLayout"
+                },
+                "page": {
+                    "$ref": "#/definitions/models.PageLayout"
+                },
+                "pdf": {
+                    "$ref": "#/definitions/models.PDFOptions"
+                },
+                "qr": {
+                    "$ref": "#/definitions/models.QROptions"
+                },
                 "template": {
                     "type": "string"
+                },
+                "theme": {
+                    "$ref": "#/definitions/models.Theme"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PDFOptions": {
+            "type": "object",
+            "properties": {
+                "profile": {
+                    "type": "string",
+                    "example": "pdfa-2b"
+                },
+                "tagged": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.PageLayout": {
+            "type": "object",
+            "properties": {
+                "footer": {
+                    "type": "string",
+                    "example": "Page {page} of {pages}"
+                },
+                "header": {
+                    "type": "string",
+                    "example": "{name}"
+                },
+                "margins": {
+                    "$ref": "#/definitions/models.PageMargins"
+                },
+                "orientation": {
+                    "type": "string",
+                    "example": "portrait"
+                },
+                "size": {
+                    "type": "string",
+                    "example": "A4"
+                }
+            }
+        },
+        "models.PageMargins": {
+            "type": "object",
+            "properties": {
+                "bottom": {
+                    "type": "number"
+                },
+                "left": {
+                    "type": "number"
+                },
+                "right": {
+                    "type": "number"
+                },
+                "top": {
+                    "type": "number"
                 }
             }
         },
@@ -1428,13 +2924,87 @@ const docTemplate = `{
         "models.Project": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
-                },
+                "description": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "entity": {
+                    "type": "string"
+                },
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Publication": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "publisher": {
+                    "type": "string"
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.QROptions": {
+            "type": "object",
+            "properties": {
+                "network": {
+                    "type": "string",
+                    "example": "LinkedIn"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "profile"
+                }
+            }
+        },
+        "models.Reference": {
+            "type": "object",
+            "properties": {
                 "name": {
                     "type": "string"
                 },
-                "url": {
+                "reference": {
                     "type": "string"
                 }
             }
@@ -1487,6 +3057,10 @@ const docTemplate = `{
                 },
                 "userID": {
                     "type": "string"
+                },
+                "watermark": {
+                    "description": "Watermark is the text printed across a draft, empty for the final document",
+                    "type": "string"
                 }
             }
         },
@@ -1512,12 +3086,118 @@ const docTemplate = `{
                 },
                 "path": {
                     "type": "string"
+                },
+                "variants": {
+                    "description": "Variants are the URLs of every size of the photo, keyed by variant",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.Resume": {
+            "type": "object",
+            "properties": {
+                "awards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Award"
+                    }
+                },
+                "basics": {
+                    "$ref": "#/definitions/models.Basics"
+                },
+                "certificates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Certificate"
+                    }
+                },
+                "customSections": {
+                    "description": "CustomSections are the sections titled by the user",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CustomSection"
+                    }
+                },
+                "education": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Education"
+                    }
+                },
+                "interests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Interest"
+                    }
+                },
+                "labels": {
+                    "$ref": "#/definitions/models.ResumeLabels"
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Language"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/models.Meta"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Project"
+                    }
+                },
+                "publications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Publication"
+                    }
+                },
+                "references": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Reference"
+                    }
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Skill"
+                    }
+                },
+                "softSkills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Skill"
+                    }
+                },
+                "volunteer": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Volunteer"
+                    }
+                },
+                "work": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Work"
+                    }
                 }
             }
         },
         "models.ResumeGenetare": {
             "type": "object",
             "properties": {
+                "awards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Award"
+                    }
+                },
                 "basics": {
                     "$ref": "#/definitions/models.Basics"
                 },
@@ -1527,6 +3207,13 @@ const docTemplate = `{
                         "$ref": "#/definitions/models.Certificate"
                     }
                 },
+                "customSections": {
+                    "description": "CustomSections are the sections titled by the user",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CustomSection"
+                    }
+                },
                 "education": {
                     "type": "array",
                     "items": {
@@ -1561,6 +3248,18 @@ const docTemplate = `{
                         "$ref": "#/definitions/models.Project"
                     }
                 },
+                "publications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Publication"
+                    }
+                },
+                "references": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Reference"
+                    }
+                },
                 "salary": {
                     "type": "integer"
                 },
@@ -1576,6 +3275,12 @@ const docTemplate = `{
                         "$ref": "#/definitions/models.Skill"
                     }
                 },
+                "volunteer": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Volunteer"
+                    }
+                },
                 "work": {
                     "type": "array",
                     "items": {
@@ -1587,6 +3292,12 @@ const docTemplate = `{
         "models.ResumeLabels": {
             "type": "object",
             "properties": {
+                "awards": {
+                    "type": "string"
+                },
+                "certifications": {
+                    "type": "string"
+                },
                 "education": {
                     "type": "string"
                 },
@@ -1605,6 +3316,12 @@ const docTemplate = `{
                 "projects": {
                     "type": "string"
                 },
+                "publications": {
+                    "type": "string"
+                },
+                "references": {
+                    "type": "string"
+                },
                 "since": {
                     "type": "string"
                 },
@@ -1613,17 +3330,57 @@ const docTemplate = `{
                 },
                 "softSkills": {
                     "type": "string"
+                },
+                "volunteer": {
+                    "type": "string"
                 }
             }
         },
         "models.ResumeResponse": {
             "type": "object",
             "properties": {
+                "files": {
+                    "description": "Files holds the URL of every requested output format, keyed by format",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "resume": {
                     "type": "string"
                 }
             }
         },
+        "models.SectionLayout": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "hidden": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "interests"
+                    ]
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "education",
+                        "work"
+                    ]
+                }
+            }
+        },
         "models.Skill": {
             "type": "object",
             "properties": {
@@ -1641,6 +3398,136 @@ const docTemplate = `{
                 }
             }
         },
+        "models.Template": {
+            "type": "object",
+            "properties": {
+                "base_font_size": {
+                    "description": "BaseFontSize is the body text size in pixels, meta.theme.fontSize replaces it",
+                    "type": "number",
+                    "example": 14
+                },
+                "cover_letter": {
+                    "description": "CoverLetter tells whether cover letters can be rendered with the template",
+                    "type": "boolean"
+                },
+                "custom": {
+                    "description": "Custom templates were uploaded by the user and only they can use them",
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string",
+                    "example": "Classic"
+                },
+                "fonts": {
+                    "description": "Fonts are the bundled font families of the template, meta.theme.fontFamily replaces them",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Fira Sans",
+                        "Open Sans"
+                    ]
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "en",
+                        "fr"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "classic"
+                },
+                "orientations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "portrait"
+                    ]
+                },
+                "paper_sizes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "A4",
+                        "Letter"
+                    ]
+                },
+                "preview_url": {
+                    "type": "string",
+                    "example": "/v1/templates/classic/preview"
+                },
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "basics",
+                        "work",
+                        "education"
+                    ]
+                },
+                "side_sections": {
+                    "description": "SideSections are in the side column by default, empty for single column templates",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "education",
+                        "skills"
+                    ]
+                }
+            }
+        },
+        "models.TemplateList": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Template"
+                    }
+                }
+            }
+        },
+        "models.Theme": {
+            "type": "object",
+            "properties": {
+                "accentColor": {
+                    "type": "string",
+                    "example": "#1F4E79"
+                },
+                "density": {
+                    "type": "string",
+                    "example": "normal"
+                },
+                "fontFamily": {
+                    "type": "string",
+                    "example": "Open Sans"
+                },
+                "fontSize": {
+                    "type": "number",
+                    "example": 13
+                }
+            }
+        },
         "models.TokenResp": {
             "type": "object",
             "properties": {
@@ -1761,6 +3648,49 @@ const docTemplate = `{
                 }
             }
         },
+        "models.ValidationError": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Volunteer": {
+            "type": "object",
+            "properties": {
+                "endDate": {
+                    "type": "string"
+                },
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "organization": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "models.Work": {
             "type": "object",
             "properties": {
@@ -1770,9 +3700,18 @@ const docTemplate = `{
                 "contract_type": {
                     "type": "string"
                 },
+                "description": {
+                    "type": "string"
+                },
                 "endDate": {
                     "type": "string"
                 },
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "location": {
                     "type": "string"
                 },
@@ -1790,6 +3729,9 @@ const docTemplate = `{
                 },
                 "summary": {
                     "type": "string"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         }
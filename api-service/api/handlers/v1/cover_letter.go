@@ -0,0 +1,346 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/genproto/resume_service"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
+	l "github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/logger"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/parser"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/pdf"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/utils"
+)
+
+const (
+	// coverLetterFileName is the name given to cover letters before they get a unique name in storage
+	coverLetterFileName = "cover_letter.pdf"
+	coverLetterDate     = "2006-01-02"
+)
+
+// renderCoverLetter prints the cover letter as a PDF, owner is the user whose custom
+// templates may be used. Errors caused by the request itself are wrapped in ErrBadRequest.
+func (h *HandlerV1) renderCoverLetter(ctx context.Context, letter models.CoverLetter, owner string, now time.Time) ([]byte, error) {
+	if len(letter.Paragraphs) == 0 {
+		return nil, errorpkg.NewErrBadRequest(errors.New("the cover letter has no paragraph"))
+	}
+	if len(letter.Paragraphs) > models.MaxLetterParagraphs {
+		return nil, errorpkg.NewErrBadRequest(fmt.Errorf("a cover letter has at most %d paragraphs", models.MaxLetterParagraphs))
+	}
+	if utf8.RuneCountInString(letter.Recipient) > models.MaxLetterFieldLen {
+		return nil, errorpkg.NewErrBadRequest(fmt.Errorf("recipient is longer than %d characters", models.MaxLetterFieldLen))
+	}
+	if utf8.RuneCountInString(letter.Company) > models.MaxLetterFieldLen {
+		return nil, errorpkg.NewErrBadRequest(fmt.Errorf("company is longer than %d characters", models.MaxLetterFieldLen))
+	}
+	if _, err := time.Parse(coverLetterDate, letter.Date); err != nil {
+		return nil, errorpkg.NewErrBadRequest(fmt.Errorf("date %q is not written as YYYY-MM-DD", letter.Date))
+	}
+
+	manifest, err := h.templates.Lookup(letter.Meta.Template, owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.templates.ValidatePageLayout(letter.Meta.Template, owner, letter.Meta.Page); err != nil {
+		return nil, errorpkg.NewErrBadRequest(err)
+	}
+	if err := template.ValidateTheme(letter.Meta.Theme); err != nil {
+		return nil, errorpkg.NewErrBadRequest(err)
+	}
+	// the letter is written with the basics and the meta of a resume
+	resumeData := models.Resume{Basics: letter.Basics, Meta: letter.Meta}
+	if err := template.ValidateGlyphCoverage(manifest, resumeData); err != nil {
+		return nil, errorpkg.NewErrBadRequest(err)
+	}
+
+	pageOptions, err := pdf.NewPageOptions(letter.Meta.Page, letter.Basics.Name, now)
+	if err != nil {
+		return nil, errorpkg.NewErrBadRequest(err)
+	}
+	documentOptions, err := pdf.NewDocumentOptions(resumeData, now)
+	if err != nil {
+		return nil, errorpkg.NewErrBadRequest(err)
+	}
+	documentOptions.Subject = strings.TrimSpace(letter.Company)
+	if err := h.inlinePhoto(ctx, &letter.Basics); err != nil {
+		return nil, err
+	}
+
+	html, err := parser.NewHTMLParser(h.templates).ParseCoverLetterToHtml(letter)
+	if err != nil {
+		return nil, err
+	}
+
+	return pdf.NewPDFGenerator(h.pdfPool).GenerateFromHTML(ctx, html, pageOptions, documentOptions)
+}
+
+// GenerateCoverLetter
+// @Security 		BearerAuth
+// @Summary 		Generate a Cover Letter
+// @Description 	This API prints a cover letter with the basics of a resume and the letter layout of meta.template, then stores it with the resumes of the user
+// @Tags 			COVER-LETTER
+// @Accept			json
+// @Produce 		json
+// @Param 			data body models.CoverLetter true "Cover letter"
+// @Param 			Accept-Language header string false "Language of the greeting and the closing when meta.lang is empty, English by default"
+// @Success 		201 {object} models.CoverLetterRes
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/cover-letters [POST]
+func (h *HandlerV1) GenerateCoverLetter(c *gin.Context) {
+	userID, status := GetIdFromToken(c.Request, h.Config)
+	if status == http.StatusUnauthorized {
+		c.JSON(http.StatusUnauthorized, models.Error{
+			Message: "Token is invalid",
+		})
+		return
+	}
+
+	var letter models.CoverLetter
+	if err := c.ShouldBindJSON(&letter); err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: models.WrongInfoMessage,
+		})
+		return
+	}
+
+	now := time.Now()
+	letter.Meta.Lang = resumeLang(c, letter.Meta.Lang)
+	if letter.Meta.Template == "" {
+		letter.Meta.Template = models.ClassicTemplate
+	}
+	if letter.Date == "" {
+		letter.Date = now.Format(coverLetterDate)
+	}
+
+	pdfData, err := h.renderCoverLetter(c.Request.Context(), letter, userID, now)
+	if err != nil {
+		var badRequest *errorpkg.ErrBadRequest
+		if errors.As(err, &badRequest) {
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+		h.Logger.Error("renderCoverLetter : " + err.Error())
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: "failed to generate cover letter",
+		})
+		return
+	}
+
+	url, err := GeneratePDFminio(createMultipartFileHeader(coverLetterFileName, pdfData), letter.Basics.Name, c, h.Config)
+	if err != nil {
+		h.Logger.Error("GeneratePDFminio : " + err.Error())
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: "failed to generate PDF in minio",
+		})
+		return
+	}
+
+	content, err := json.Marshal(letter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error(fmt.Sprintf("failed to marshal cover letter content %v", err))
+		return
+	}
+
+	stored := &resume_service.CoverLetter{
+		Id:        uuid.NewString(),
+		UserId:    userID,
+		Url:       url,
+		Template:  letter.Meta.Template,
+		Recipient: letter.Recipient,
+		Company:   letter.Company,
+		Date:      letter.Date,
+		Content:   string(content),
+	}
+	if _, err := h.Service.ResumeService().CreateCoverLetter(context.Background(), stored); err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error(fmt.Sprintf("failed to save cover letter into service %v", err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, coverLetterFromProto(stored))
+}
+
+// ListCoverLetters
+// @Security 		BearerAuth
+// @Summary 		List Cover Letters
+// @Description 	This API lists the cover letters of the user, the latest first
+// @Tags 			COVER-LETTER
+// @Produce 		json
+// @Param 			request query models.Pagination true "request"
+// @Success 		200 {object} models.CoverLetterList
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/cover-letters [GET]
+func (h *HandlerV1) ListCoverLetters(c *gin.Context) {
+	userID, status := GetIdFromToken(c.Request, h.Config)
+	if status == http.StatusUnauthorized {
+		c.JSON(http.StatusUnauthorized, models.Error{
+			Message: "Token is invalid",
+		})
+		return
+	}
+
+	params, errStr := utils.ParseQueryParam(c.Request.URL.Query())
+	if errStr != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: errStr[0],
+		})
+		return
+	}
+
+	response, err := h.Service.ResumeService().GetUserCoverLetters(context.Background(), &resume_service.UserWithID{
+		Page:   params.Page,
+		Limit:  params.Limit,
+		UserId: userID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("failed to list cover letters", l.Error(err))
+		return
+	}
+
+	letters := models.CoverLetterList{
+		CoverLetters: []models.CoverLetterRes{},
+		Count:        response.TotalCount,
+	}
+	for _, letter := range response.CoverLetters {
+		letters.CoverLetters = append(letters.CoverLetters, coverLetterFromProto(letter))
+	}
+
+	c.JSON(http.StatusOK, letters)
+}
+
+// GetCoverLetter
+// @Security 		BearerAuth
+// @Summary 		Get a Cover Letter
+// @Description 	This API returns a cover letter of the user with the letter it was generated from
+// @Tags 			COVER-LETTER
+// @Produce 		json
+// @Param 			id path string true "Cover letter ID"
+// @Success 		200 {object} models.CoverLetterRes
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		404 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/cover-letters/{id} [GET]
+func (h *HandlerV1) GetCoverLetter(c *gin.Context) {
+	stored, ok := h.ownCoverLetter(c)
+	if !ok {
+		return
+	}
+
+	res := coverLetterFromProto(stored)
+	var letter models.CoverLetter
+	if err := json.Unmarshal([]byte(stored.Content), &letter); err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("failed to unmarshal stored cover letter content", l.Error(err))
+		return
+	}
+	res.Letter = &letter
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteCoverLetter
+// @Security 		BearerAuth
+// @Summary 		Delete a Cover Letter
+// @Description 	This API deletes a cover letter of the user
+// @Tags 			COVER-LETTER
+// @Produce 		json
+// @Param 			id path string true "Cover letter ID"
+// @Success 		200 {object} models.RegisterRes
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		404 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/cover-letters/{id} [DELETE]
+func (h *HandlerV1) DeleteCoverLetter(c *gin.Context) {
+	stored, ok := h.ownCoverLetter(c)
+	if !ok {
+		return
+	}
+
+	_, err := h.Service.ResumeService().DeleteCoverLetter(context.Background(), &resume_service.CoverLetterWithID{
+		CoverLetterId: stored.Id,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("failed to delete cover letter", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, &models.RegisterRes{
+		Content: "Cover letter has been deleted",
+	})
+}
+
+// ownCoverLetter returns the cover letter of the id path parameter when it belongs to
+// the user of the token, otherwise it writes the error response.
+func (h *HandlerV1) ownCoverLetter(c *gin.Context) (*resume_service.CoverLetter, bool) {
+	userID, status := GetIdFromToken(c.Request, h.Config)
+	if status == http.StatusUnauthorized {
+		c.JSON(http.StatusUnauthorized, models.Error{
+			Message: "Token is invalid",
+		})
+		return nil, false
+	}
+
+	letter, err := h.Service.ResumeService().GetCoverLetterByID(context.Background(), &resume_service.CoverLetterWithID{
+		CoverLetterId: c.Param("id"),
+	})
+	if err != nil || letter == nil {
+		c.JSON(http.StatusNotFound, models.Error{
+			Message: models.NotFoundMessage,
+		})
+		h.Logger.Error("failed to get cover letter", l.Error(err))
+		return nil, false
+	}
+
+	if letter.UserId != userID {
+		c.JSON(http.StatusForbidden, models.Error{
+			Message: "cover letter belongs to another user",
+		})
+		return nil, false
+	}
+
+	return letter, true
+}
+
+func coverLetterFromProto(letter *resume_service.CoverLetter) models.CoverLetterRes {
+	return models.CoverLetterRes{
+		ID:        letter.Id,
+		URL:       letter.Url,
+		Template:  letter.Template,
+		Recipient: letter.Recipient,
+		Company:   letter.Company,
+		Date:      letter.Date,
+		CreatedAt: letter.CreatedAt,
+	}
+}
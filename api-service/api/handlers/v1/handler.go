@@ -10,6 +10,8 @@ import (
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/infrastructure/rabbitmq"
 	repo "github.com/dostonshernazarov/resume_maker/api-service/internal/infrastructure/repository/redis"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/config"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/pdf"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
 	tokens "github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/token"
 
 	appV "github.com/dostonshernazarov/resume_maker/api-service/internal/usecase/app_version"
@@ -27,6 +29,8 @@ type HandlerV1 struct {
 	// Enforcer       *casbin.Enforcer
 	redisStorage repo.Cache
 	writer       *rabbitmq.RabbitMQProducerImpl
+	pdfPool      *pdf.Pool
+	templates    *template.Manager
 }
 
 type HandlerV1Config struct {
@@ -40,6 +44,8 @@ type HandlerV1Config struct {
 	Enforcer       *casbin.Enforcer
 	Redis          repo.Cache
 	Writer         *rabbitmq.RabbitMQProducerImpl
+	PdfPool        *pdf.Pool
+	Templates      *template.Manager
 }
 
 func New(c *HandlerV1Config) *HandlerV1 {
@@ -54,5 +60,7 @@ func New(c *HandlerV1Config) *HandlerV1 {
 		// Enforcer:       c.Enforcer,
 		redisStorage: c.Redis,
 		writer:       c.Writer,
+		pdfPool:      c.PdfPool,
+		templates:    c.Templates,
 	}
 }
@@ -2,31 +2,32 @@ package v1
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"io"
 	"log"
+	"mime/multipart"
 	"net/http"
-	"os"
-	"path/filepath"
 	"time"
 
 	_ "github.com/dostonshernazarov/resume_maker/api-service/api/docs"
 	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
 	pbu "github.com/dostonshernazarov/resume_maker/api-service/genproto/user_service"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/config"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/photo"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
 // UploadMedia
 // @Summary     Upload User photo
 // @Security    BearerAuth
-// @Description Through this api front-ent can upload user photo and get the link to the media.
+// @Description Through this api front-ent can upload user photo and get the link to the media. The photo is checked from its content, turned upright, stripped of its EXIF metadata and cropped into square JPEG variants, the thumbnail becomes the photo of the user.
 // @Tags        MEDIA
 // @Accept      json
 // @Produce     json
-// @Param       file formData file true "Image"
+// @Param       file formData file true "JPEG, PNG or WebP image"
 // @Success     200 {object} string
 // @Failure     400 {object} models.Error
 // @Failure     500 {object} models.Error
@@ -43,29 +44,6 @@ func (h *HandlerV1) UploadMedia(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(context.Background(), duration)
 	defer cancel()
 
-	endpoint := h.Config.Minio.Host + h.Config.Minio.Port
-	accessKeyID := h.Config.Minio.AccessKey
-	secretAccessKey := h.Config.Minio.SecretKey
-	bucketName := h.Config.Minio.BucketName
-	minioClient, err := minio.New(endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(accessKeyID, secretAccessKey, ""),
-		Secure: false,
-	})
-	if err != nil {
-		panic(err)
-	}
-	err = minioClient.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{})
-	if err != nil {
-		if minio.ToErrorResponse(err).Code == "BucketAlreadyOwnedByYou" {
-		} else {
-			c.JSON(http.StatusInternalServerError, models.Error{
-				Message: err.Error(),
-			})
-			log.Println(err.Error())
-			return
-		}
-	}
-
 	userID, statusCode := GetIdFromToken(c.Request, h.Config)
 	if statusCode == 401 {
 		c.JSON(http.StatusUnauthorized, models.Error{
@@ -86,29 +64,6 @@ func (h *HandlerV1) UploadMedia(c *gin.Context) {
 		return
 	}
 
-	policy := fmt.Sprintf(`{
-        "Version": "2012-10-17",
-        "Statement": [
-            {
-                "Effect": "Allow",
-                "Principal": {
-                    "AWS": ["*"]
-                },
-                "Action": ["s3:GetObject"],
-                "Resource": ["arn:aws:s3:::%s/*"]
-            }
-        ]
-    }`, bucketName)
-
-	err = minioClient.SetBucketPolicy(context.Background(), bucketName, policy)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: err.Error(),
-		})
-		log.Println(err.Error())
-		return
-	}
-
 	file := &models.File{}
 	err = c.ShouldBind(&file)
 	if err != nil {
@@ -119,71 +74,68 @@ func (h *HandlerV1) UploadMedia(c *gin.Context) {
 		return
 	}
 
-	if file.File.Size > 10<<20 {
-		c.JSON(http.StatusBadRequest, models.Error{
-			Message: "File size cannot be larger than 10 MB",
-		})
-		return
-	}
-
-	ext := filepath.Ext(file.File.Filename)
-
-	if ext != ".png" && ext != ".jpg" && ext != ".svg" && ext != ".jpeg" {
-		c.JSON(http.StatusBadRequest, models.Error{
-			Message: "Only .jpg and .png format images are accepted",
-		})
-		return
-	}
-
-	uploadDir := "./media"
-	if _, err := os.Stat(uploadDir); os.IsNotExist(err) {
-		err := os.Mkdir(uploadDir, os.ModePerm)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, models.Error{
-				Message: models.InternalMessage,
+	urls, err := storePhoto(file.File, c, h.Config)
+	if err != nil {
+		var badRequest *errorpkg.ErrBadRequest
+		if errors.As(err, &badRequest) {
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: err.Error(),
 			})
-			log.Println("Error creating media directory", err.Error())
 			return
 		}
-	}
-
-	id := uuid.New().String()
-
-	newFilename := id + ext
-	uploadPath := filepath.Join(uploadDir, newFilename)
-
-	if err := c.SaveUploadedFile(file.File, uploadPath); err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: err.Error(),
+			Message: models.InternalMessage,
 		})
-		log.Println(err)
+		h.Logger.Error("storePhoto : " + err.Error())
 		return
 	}
 
-	objectName := newFilename
-	contentType := "image/jpeg"
-	_, err = minioClient.FPutObject(context.Background(), bucketName, objectName, uploadPath, minio.PutObjectOptions{
-		ContentType: contentType,
-	})
-
+	user.Image = urls[photo.VariantThumbnail]
+	_, err = h.Service.UserService().UpdateUser(ctx, user)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: err.Error(),
+			Message: models.InternalMessage,
 		})
-		log.Println(err)
 		return
 	}
 
-	minioURL := fmt.Sprintf("https://media.cvmaker.uz/%s/%s", bucketName, objectName)
+	c.JSON(http.StatusOK, user.Image)
+}
 
-	user.Image = minioURL
-	_, err = h.Service.UserService().UpdateUser(ctx, user)
+// storePhoto processes an uploaded photo and stores every variant of it in minio, it
+// returns their URLs keyed by variant. Errors caused by the upload itself are wrapped
+// in ErrBadRequest.
+func storePhoto(fileHeader *multipart.FileHeader, c *gin.Context, cfg *config.Config) (map[string]string, error) {
+	if fileHeader.Size > photo.MaxSize {
+		return nil, errorpkg.NewErrBadRequest(errors.New("File size cannot be larger than 10 MB"))
+	}
+
+	file, err := fileHeader.Open()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: models.InternalMessage,
-		})
-		return
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, photo.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := photo.Process(data)
+	if err != nil {
+		return nil, errorpkg.NewErrBadRequest(err)
+	}
+
+	id := uuid.New().String()
+	urls := make(map[string]string, len(variants))
+	for name, variant := range variants {
+		objectName := photo.ObjectName(id, name)
+		url, err := storeMinio(createMultipartFileHeader(objectName, variant), objectName, c, cfg)
+		if err != nil {
+			return nil, err
+		}
+		urls[name] = url
 	}
 
-	c.JSON(http.StatusOK, minioURL)
+	return urls, nil
 }
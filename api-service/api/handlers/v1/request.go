@@ -31,3 +31,13 @@ func GetIdFromToken(r *http.Request, cfg *config.Config) (string, int) {
 
 	return resp, 200
 }
+
+// templateOwner returns the user of the request token whose custom templates may be
+// used, empty for anonymous requests which only get the built-in templates.
+func templateOwner(r *http.Request, cfg *config.Config) string {
+	userID, status := GetIdFromToken(r, cfg)
+	if status != http.StatusOK {
+		return ""
+	}
+	return userID
+}
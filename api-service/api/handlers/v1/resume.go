@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"mime/multipart"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -18,14 +20,21 @@ import (
 	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
 	"github.com/dostonshernazarov/resume_maker/api-service/api/services"
 	"github.com/dostonshernazarov/resume_maker/api-service/genproto/resume_service"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/config"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/docx"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/fonts"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/jsonresume"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/linkedin"
 	l "github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/logger"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/parser"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/pdf"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/photo"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/qrcode"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/utils"
 	val "github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/validation"
-	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/fs"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/lang"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/minio/minio-go/v7"
@@ -33,29 +42,252 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-func createMultipartFileHeader(filePath string) *multipart.FileHeader {
-	// open the file
-	file, err := os.Open(filePath)
+// Formats of the resume preview.
+const (
+	previewHTML = "html"
+	previewPNG  = "png"
+)
+
+// resumeFileName is the name given to generated documents before they get a unique name in storage.
+const resumeFileName = "resume"
+
+// uploadDir keeps a local copy of the files stored in minio.
+const uploadDir = "./media"
+
+// documentContentTypes maps generated file extensions to the content type stored in minio.
+var documentContentTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".txt":  "text/plain; charset=utf-8",
+	".md":   "text/markdown; charset=utf-8",
+	".jpg":  "image/jpeg",
+}
+
+// renderedDocument is one output format of a generated resume.
+type renderedDocument struct {
+	Format   string
+	FileName string
+	Data     []byte
+	// ObjectName is the name the document is stored under in minio
+	ObjectName string
+}
+
+// outputExtensions are the file extensions of the output formats.
+var outputExtensions = map[string]string{
+	models.OutputPDF:      ".pdf",
+	models.OutputDOCX:     ".docx",
+	models.OutputText:     ".txt",
+	models.OutputMarkdown: ".md",
+}
+
+// parseOutputs splits the comma separated output query into distinct formats, PDF by default.
+// The first format is the primary document of the resume.
+func parseOutputs(output string) []string {
+	var outputs []string
+	seen := make(map[string]bool)
+	for _, format := range strings.Split(output, ",") {
+		format = strings.ToLower(strings.TrimSpace(format))
+		if format == "" {
+			format = models.OutputPDF
+		}
+		if seen[format] {
+			continue
+		}
+		seen[format] = true
+		outputs = append(outputs, format)
+	}
+	return outputs
+}
+
+// renderResumes renders resumeData in every format requested by the output query,
+// owner is the user whose custom templates may be used and watermark marks a draft.
+func (h *HandlerV1) renderResumes(ctx context.Context, resumeData models.Resume, output, owner, watermark string) ([]renderedDocument, error) {
+	outputs := parseOutputs(output)
+
+	// documents are named before they are rendered, the QR code may point at the primary one
+	objectNames := make([]string, len(outputs))
+	for i, format := range outputs {
+		objectNames[i] = resumeObjectName(resumeData.Basics.Name, outputExtensions[format])
+	}
+	if err := setQRCode(&resumeData, minioObjectURL(h.Config, objectNames[0])); err != nil {
+		return nil, err
+	}
+
+	var documents []renderedDocument
+	for i, format := range outputs {
+		fileName, data, err := h.renderResume(ctx, resumeData, format, owner, watermark)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, renderedDocument{
+			Format:     format,
+			FileName:   fileName,
+			Data:       data,
+			ObjectName: objectNames[i],
+		})
+	}
+	return documents, nil
+}
+
+// setQRCode draws the QR code asked for by meta.qr into the basics of resumeData,
+// hostedURL is where the primary document of the resume is stored.
+func setQRCode(resumeData *models.Resume, hostedURL string) error {
+	link, err := qrcode.Link(resumeData.Basics, resumeData.Meta.QR, hostedURL)
 	if err != nil {
-		log.Fatal(err)
+		return errorpkg.NewErrBadRequest(err)
+	}
+	if link == "" {
+		return nil
+	}
+
+	svg, err := qrcode.SVG(link)
+	if err != nil {
+		return err
+	}
+	resumeData.Basics.QRCode = svg
+	return nil
+}
+
+// uploadResumes stores every rendered document in minio and returns their URLs keyed by format.
+func uploadResumes(documents []renderedDocument, c *gin.Context, cfg *config.Config) (map[string]string, error) {
+	files := make(map[string]string, len(documents))
+	for _, document := range documents {
+		multipartFile := createMultipartFileHeader(document.FileName, document.Data)
+
+		url, err := storeMinio(multipartFile, document.ObjectName, c, cfg)
+		if err != nil {
+			return nil, err
+		}
+		files[document.Format] = url
+	}
+	return files, nil
+}
+
+// inlinePhoto writes the photo of basics as a data URI, Chrome renders documents
+// without any network access. Photos that cannot be printed are errors of the request.
+func (h *HandlerV1) inlinePhoto(ctx context.Context, basics *models.Basics) error {
+	if basics.Image == "" {
 		return nil
 	}
-	defer file.Close()
 
+	fetcher := photo.NewFetcher(h.Config.Photos.AllowedHosts, h.Config.Photos.FetchTimeout)
+	image, err := fetcher.DataURI(ctx, photo.VariantURL(basics.Image, photo.VariantTemplate))
+	if err != nil {
+		if errors.Is(err, photo.ErrRejected) {
+			return errorpkg.NewErrBadRequest(err)
+		}
+		return err
+	}
+	basics.Image = image
+	return nil
+}
+
+// renderResume renders resumeData in the requested output format, PDF by default.
+// It returns the file name carrying the format extension and the file content.
+// A draft is printed with watermark across its pages, only PDF can carry it.
+// Errors caused by the request itself are wrapped in ErrBadRequest.
+func (h *HandlerV1) renderResume(ctx context.Context, resumeData models.Resume, output, owner, watermark string) (string, []byte, error) {
+	htmlParser := parser.NewHTMLParser(h.templates)
+	pdfGenerator := pdf.NewPDFGenerator(h.pdfPool)
+	service := services.NewResumeService(htmlParser, pdfGenerator, docx.NewRenderer())
+
+	// only PDF uses the template, an unknown name is still a mistake in the request
+	manifest, err := h.templates.Lookup(resumeData.Meta.Template, owner)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if watermark != "" && output != "" && output != models.OutputPDF {
+		return "", nil, errorpkg.NewErrBadRequest(fmt.Errorf("watermarks are only printed on PDF, not on %q", output))
+	}
+
+	switch output {
+	case "", models.OutputPDF:
+		if err := h.templates.ValidatePageLayout(resumeData.Meta.Template, owner, resumeData.Meta.Page); err != nil {
+			return "", nil, errorpkg.NewErrBadRequest(err)
+		}
+		if err := template.ValidateTheme(resumeData.Meta.Theme); err != nil {
+			return "", nil, errorpkg.NewErrBadRequest(err)
+		}
+		if err := template.ValidateSectionLayout(resumeData.Meta.Layout); err != nil {
+			return "", nil, errorpkg.NewErrBadRequest(err)
+		}
+		if err := template.ValidateGlyphCoverage(manifest, resumeData); err != nil {
+			return "", nil, errorpkg.NewErrBadRequest(err)
+		}
+
+		// the template sizes its pages with the margins of the draft too
+		resumeData.Meta.Page.Draft = watermark != ""
+
+		now := time.Now()
+		pageOptions, err := pdf.NewPageOptions(resumeData.Meta.Page, resumeData.Basics.Name, now)
+		if err != nil {
+			return "", nil, errorpkg.NewErrBadRequest(err)
+		}
+		documentOptions, err := pdf.NewDocumentOptions(resumeData, now)
+		if err != nil {
+			return "", nil, errorpkg.NewErrBadRequest(err)
+		}
+		if watermark != "" {
+			pageOptions = pageOptions.WithDraft(watermark, template.DraftStamp(resumeData.Meta.Lang, now))
+		}
+		if err := h.inlinePhoto(ctx, &resumeData.Basics); err != nil {
+			return "", nil, err
+		}
+
+		html, err := service.Parser.ParseToHtml(resumeData)
+		if err != nil {
+			return "", nil, err
+		}
+
+		pdfData, err := service.Pdf.GenerateFromHTML(ctx, html, pageOptions, documentOptions)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return resumeFileName + ".pdf", pdfData, nil
+	case models.OutputDOCX:
+		docxData, err := service.Docx.Render(resumeData)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return resumeFileName + ".docx", docxData, nil
+	case models.OutputText:
+		textData, err := service.Text.Render(resumeData)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return resumeFileName + ".txt", textData, nil
+	case models.OutputMarkdown:
+		markdownData, err := service.Markdown.Render(resumeData)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return resumeFileName + ".md", markdownData, nil
+	default:
+		return "", nil, errorpkg.NewErrBadRequest(fmt.Errorf("unknown output format %q", output))
+	}
+}
+
+// createMultipartFileHeader wraps in-memory file data into a multipart file header.
+func createMultipartFileHeader(filename string, data []byte) *multipart.FileHeader {
 	// create a buffer to hold the file in memory
 	var buff bytes.Buffer
 	buffWriter := io.Writer(&buff)
 
 	// create a new form and create a new file field
 	formWriter := multipart.NewWriter(buffWriter)
-	formPart, err := formWriter.CreateFormFile("file", filepath.Base(file.Name()))
+	formPart, err := formWriter.CreateFormFile("file", filename)
 	if err != nil {
 		log.Fatal(err)
 		return nil
 	}
 
 	// copy the content of the file to the form's file field
-	if _, err := io.Copy(formPart, file); err != nil {
+	if _, err := formPart.Write(data); err != nil {
 		log.Fatal(err)
 		return nil
 	}
@@ -68,8 +300,8 @@ func createMultipartFileHeader(filePath string) *multipart.FileHeader {
 	buffReader := bytes.NewReader(buff.Bytes())
 	formReader := multipart.NewReader(buffReader, formWriter.Boundary())
 
-	// read the form components with max stored memory of 1MB
-	multipartForm, err := formReader.ReadForm(1 << 20)
+	// read the form components fully in memory so no temporary file is left behind
+	multipartForm, err := formReader.ReadForm(int64(buff.Len()) + 1<<20)
 	if err != nil {
 		log.Fatal(err)
 		return nil
@@ -203,11 +435,14 @@ func (h HandlerV1) MainResumeData(c *gin.Context) {
 // GenerateResume
 // @Security 		BearerAuth
 // @Summary 		Generate a Resume
-// @Description 	This API for generate a resume
+// @Description 	This API for generate a resume. When several output formats are requested it returns models.ResumeResponse with the URL of each file
 // @Tags 			STEP-RESUME
 // @Accept			json
 // @Produce 		json
 // @Param 			data body models.LastResumeReq true "Resume Model"
+// @Param 			output query string false "Comma separated output formats: pdf (default), docx, txt or md. The first one is stored with the resume"
+// @Param 			watermark query string false "Prints a draft: draft, confidential or a custom text of up to 40 characters across every page, with the generation time in the footer. Only for PDF, drafts are never broadcast"
+// @Param 			Accept-Language header string false "Language of the labels when meta.lang is empty, English by default"
 // @Success 		200 {object} string "Resume URL"
 // @Failure 		400 {object} models.Error
 // @Failure 		401 {object} models.Error
@@ -215,11 +450,6 @@ func (h HandlerV1) MainResumeData(c *gin.Context) {
 // @Failure 		500 {object} models.Error
 // @Router 			/v1/resume/generate [POST]
 func (h *HandlerV1) LastGenerateResume(c *gin.Context) {
-	templateManager := template.NewTemplateManager("ui")
-	htmlParser := parser.NewHTMLParser(models.OutputDir, models.OutputHtmlFile, templateManager)
-	pdfGenerator := pdf.NewPDFGenerator()
-	service := services.NewResumeService(htmlParser, pdfGenerator)
-
 	var Lastbody models.LastResumeReq
 	var resumeData models.Resume
 
@@ -270,46 +500,58 @@ func (h *HandlerV1) LastGenerateResume(c *gin.Context) {
 	resumeData.Work = MainDetail.Work
 	resumeData.Projects = MainDetail.Projects
 	resumeData.Education = MainDetail.Education
+	resumeData.Volunteer = MainDetail.Volunteer
 	resumeData.Certificates = Lastbody.Certificates
 	resumeData.Skills = Lastbody.Skills
 	resumeData.Languages = Lastbody.Languages
 	resumeData.Interests = Lastbody.Interests
+	resumeData.Awards = Lastbody.Awards
+	resumeData.Publications = Lastbody.Publications
+	resumeData.References = Lastbody.References
+	resumeData.CustomSections = Lastbody.CustomSections
 	resumeData.Meta = Lastbody.Meta
+	resumeData.Meta.Lang = resumeLang(c, resumeData.Meta.Lang)
 
-	htmlFile, err := service.Parser.ParseToHtml(resumeData)
+	watermark, err := pdf.ParseWatermark(c.Query("watermark"))
 	if err != nil {
-		h.Logger.Error("ParseToHtml : " + err.Error())
-		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: "failed to parse HTML",
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
 		})
 		return
 	}
 
-	pdfData, err := service.Pdf.GenerateFromHTML(htmlFile)
+	documents, err := h.renderResumes(c.Request.Context(), resumeData, c.Query("output"), templateOwner(c.Request, h.Config), watermark)
 	if err != nil {
-		h.Logger.Error("GenerateFromHTML : " + err.Error())
+		var badRequest *errorpkg.ErrBadRequest
+		if errors.As(err, &badRequest) {
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+		h.Logger.Error("renderResume : " + err.Error())
 		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: "failed to generate PDF",
+			Message: "failed to generate resume",
 		})
 		return
 	}
 
-	if err := fs.WriteFile(models.OutputPdfFile, pdfData); err != nil {
-		h.Logger.Error("WriteFile : " + err.Error())
+	files, err := uploadResumes(documents, c, h.Config)
+	if err != nil {
+		h.Logger.Error("uploadResumes : " + err.Error())
 		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: "failed to write PDF file",
+			Message: "failed to generate PDF in minio",
 		})
 		return
 	}
+	minioURL := files[documents[0].Format]
 
-	multipartFile := createMultipartFileHeader(models.OutputPdfFile)
-
-	minioURL, err := GeneratePDFminio(multipartFile, resumeData.Basics.Name, c, h.Config)
+	content, err := json.Marshal(resumeData)
 	if err != nil {
-		h.Logger.Error("GeneratePDFminio : " + err.Error())
 		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: "failed to generate PDF in minio",
+			Message: models.InternalMessage,
 		})
+		h.Logger.Error(fmt.Sprintf("failed to marshal resume content %v", err))
 		return
 	}
 
@@ -333,6 +575,8 @@ func (h *HandlerV1) LastGenerateResume(c *gin.Context) {
 		JobType:     resumeData.Basics.JobType,
 		Experience:  int64(resumeData.Basics.ExperienceYear),
 		Template:    resumeData.Meta.Template,
+		Content:     string(content),
+		Watermark:   watermark,
 	})
 
 	if err != nil {
@@ -343,6 +587,14 @@ func (h *HandlerV1) LastGenerateResume(c *gin.Context) {
 		return
 	}
 
+	if len(documents) > 1 {
+		c.JSON(http.StatusOK, models.ResumeResponse{
+			Resume: minioURL,
+			Files:  files,
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, minioURL)
 }
 
@@ -354,6 +606,9 @@ func (h *HandlerV1) LastGenerateResume(c *gin.Context) {
 // @Accept			json
 // @Produce 		json
 // @Param 			data body models.ResumeGenetare true "Resume Model"
+// @Param 			output query string false "Comma separated output formats: pdf (default), docx, txt or md. The first one is stored with the resume"
+// @Param 			watermark query string false "Prints a draft: draft, confidential or a custom text of up to 40 characters across every page, with the generation time in the footer. Only for PDF, drafts are never broadcast"
+// @Param 			Accept-Language header string false "Language of the labels when meta.lang is empty, English by default"
 // @Success 		200 {object} models.ResumeResponse
 // @Failure 		400 {object} models.Error
 // @Failure 		401 {object} models.Error
@@ -361,11 +616,6 @@ func (h *HandlerV1) LastGenerateResume(c *gin.Context) {
 // @Failure 		500 {object} models.Error
 // @Router 			/v1/resume/generate-resume [POST]
 func (h *HandlerV1) GenerateResume(c *gin.Context) {
-	templateManager := template.NewTemplateManager("ui")
-	htmlParser := parser.NewHTMLParser(models.OutputDir, models.OutputHtmlFile, templateManager)
-	pdfGenerator := pdf.NewPDFGenerator()
-	service := services.NewResumeService(htmlParser, pdfGenerator)
-
 	var body models.ResumeGenetare
 
 	if err := c.ShouldBindJSON(&body); err != nil {
@@ -375,53 +625,49 @@ func (h *HandlerV1) GenerateResume(c *gin.Context) {
 		return
 	}
 
-	var resumeData models.Resume
-	resumeData.Basics = body.Basics
-	resumeData.Certificates = body.Certificates
-	resumeData.Work = body.Work
-	resumeData.Projects = body.Projects
-	resumeData.Education = body.Education
-	resumeData.Skills = body.Skills
-	resumeData.SoftSkills = body.SoftSkills
-	resumeData.Languages = body.Languages
-	resumeData.Interests = body.Interests
-	resumeData.Meta = body.Meta
-	resumeData.Labels = body.Labels
-
-	htmlFile, err := service.Parser.ParseToHtml(resumeData)
+	resumeData := resumeFromRequest(body)
+	resumeData.Meta.Lang = resumeLang(c, resumeData.Meta.Lang)
+
+	watermark, err := pdf.ParseWatermark(c.Query("watermark"))
 	if err != nil {
-		h.Logger.Error("ParseToHtml : " + err.Error())
-		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: "failed to parse HTML",
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
 		})
 		return
 	}
 
-	pdfData, err := service.Pdf.GenerateFromHTML(htmlFile)
+	documents, err := h.renderResumes(c.Request.Context(), resumeData, c.Query("output"), templateOwner(c.Request, h.Config), watermark)
 	if err != nil {
-		h.Logger.Error("GenerateFromHTML : " + err.Error())
+		var badRequest *errorpkg.ErrBadRequest
+		if errors.As(err, &badRequest) {
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+		h.Logger.Error("renderResume : " + err.Error())
 		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: "failed to generate PDF",
+			Message: "failed to generate resume",
 		})
 		return
 	}
 
-	if err := fs.WriteFile(models.OutputPdfFile, pdfData); err != nil {
-		h.Logger.Error("WriteFile : " + err.Error())
+	files, err := uploadResumes(documents, c, h.Config)
+	if err != nil {
+		h.Logger.Error("uploadResumes : " + err.Error())
 		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: "failed to write PDF file",
+			Message: "failed to generate PDF in minio",
 		})
 		return
 	}
+	minioURL := files[documents[0].Format]
 
-	multipartFile := createMultipartFileHeader(models.OutputPdfFile)
-
-	minioURL, err := GeneratePDFminio(multipartFile, body.Basics.Name, c, h.Config)
+	content, err := json.Marshal(resumeData)
 	if err != nil {
-		h.Logger.Error("GeneratePDFminio : " + err.Error())
 		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: "failed to generate PDF in minio",
+			Message: models.InternalMessage,
 		})
+		h.Logger.Error(fmt.Sprintf("failed to marshal resume content %v", err))
 		return
 	}
 
@@ -445,6 +691,8 @@ func (h *HandlerV1) GenerateResume(c *gin.Context) {
 		JobType:     resumeData.Basics.JobType,
 		Experience:  int64(resumeData.Basics.ExperienceYear),
 		Template:    resumeData.Meta.Template,
+		Content:     string(content),
+		Watermark:   watermark,
 	})
 
 	if err != nil {
@@ -455,6 +703,15 @@ func (h *HandlerV1) GenerateResume(c *gin.Context) {
 		return
 	}
 
+	if watermark != "" {
+		// drafts are for review only, they are never broadcast
+		c.JSON(http.StatusOK, models.ResumeResponse{
+			Resume: minioURL,
+			Files:  files,
+		})
+		return
+	}
+
 	//Rabbitmq for telegram bot
 	var resumeBot models.ResumeBot
 	resumeBot.Name = resumeData.Basics.Name
@@ -483,126 +740,217 @@ func (h *HandlerV1) GenerateResume(c *gin.Context) {
 
 	c.JSON(http.StatusOK, models.ResumeResponse{
 		Resume: minioURL,
+		Files:  files,
 	})
 }
 
-// UploadMedia
-// @Summary     Upload Resume Photo
-// @Security    BearerAuth
-// @Description Through this api front-ent can upload resume photo and get the link to the resume.
-// @Tags        MEDIA
-// @Accept      json
-// @Produce     json
-// @Param       file formData file true "Image"
-// @Success     200 {object} models.ResponseUrl
-// @Failure     400 {object} models.Error
-// @Failure     500 {object} models.Error
-// @Router      /v1/resume/resume-photo [POST]
-func (h *HandlerV1) UploadResumePhoto(c *gin.Context) {
-	duration, err := time.ParseDuration(h.Config.Context.Timeout)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: err.Error(),
+// PreviewResume
+// @Security 		BearerAuth
+// @Summary 		Preview a Resume
+// @Description 	This API renders a resume to HTML, or to a PNG of its first page, without generating a PDF or storing anything. It is rate limited per user
+// @Tags 			RESUME
+// @Accept			json
+// @Produce 		html
+// @Produce 		png
+// @Param 			data body models.ResumeGenetare true "Resume Model"
+// @Param 			format query string false "Preview format: html (default) or png"
+// @Param 			Accept-Language header string false "Language of the labels when meta.lang is empty, English by default"
+// @Success 		200 {string} string "Rendered resume"
+// @Failure 		400 {object} models.Error
+// @Failure 		429 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/resume/preview [POST]
+func (h *HandlerV1) PreviewResume(c *gin.Context) {
+	var body models.ResumeGenetare
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: models.WrongInfoMessage,
 		})
-		log.Println(err.Error())
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
-	defer cancel()
 
-	endpoint := "18.199.83.250:9000"
-	accessKeyID := h.Config.Minio.AccessKey
-	secretAccessKey := h.Config.Minio.SecretKey
-	bucketName := h.Config.Minio.BucketName
-	minioClient, err := minio.New(endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(accessKeyID, secretAccessKey, ""),
-		Secure: false,
-	})
-	if err != nil {
-		panic(err)
-	}
-	err = minioClient.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{})
-	if err != nil {
-		if minio.ToErrorResponse(err).Code == "BucketAlreadyOwnedByYou" {
-		} else {
-			c.JSON(http.StatusInternalServerError, models.Error{
-				Message: err.Error(),
-			})
-			log.Println(err.Error())
-			return
-		}
+	format := c.DefaultQuery("format", previewHTML)
+	if format != previewHTML && format != previewPNG {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: fmt.Sprintf("unknown preview format %q", format),
+		})
+		return
 	}
 
-	file := &models.File{}
-	err = c.ShouldBind(&file)
+	resumeData := resumeFromRequest(body)
+	resumeData.Meta.Lang = resumeLang(c, resumeData.Meta.Lang)
+
+	owner := templateOwner(c.Request, h.Config)
+	if err := h.templates.ValidatePageLayout(resumeData.Meta.Template, owner, resumeData.Meta.Page); err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	if err := template.ValidateTheme(resumeData.Meta.Theme); err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	if err := template.ValidateSectionLayout(resumeData.Meta.Layout); err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	manifest, err := h.templates.Lookup(resumeData.Meta.Template, owner)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, models.Error{
 			Message: err.Error(),
 		})
-		log.Println(err.Error())
 		return
 	}
-
-	if file.File.Size > 10<<20 {
+	if err := template.ValidateGlyphCoverage(manifest, resumeData); err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	// the preview is not stored, the QR code shows where it would be
+	if err := setQRCode(&resumeData, minioObjectURL(h.Config, resumeObjectName(resumeData.Basics.Name, outputExtensions[models.OutputPDF]))); err != nil {
 		c.JSON(http.StatusBadRequest, models.Error{
-			Message: "File size cannot be larger than 10 MB",
+			Message: err.Error(),
 		})
 		return
 	}
 
-	ext := filepath.Ext(file.File.Filename)
-
-	if ext != ".png" && ext != ".jpg" && ext != ".svg" && ext != ".jpeg" {
+	pageOptions, err := pdf.NewPageOptions(resumeData.Meta.Page, resumeData.Basics.Name, time.Now())
+	if err != nil {
 		c.JSON(http.StatusBadRequest, models.Error{
-			Message: "Only .jpg and .png format images are accepted",
+			Message: err.Error(),
 		})
 		return
 	}
 
-	uploadDir := "./media"
-	if _, err := os.Stat(uploadDir); os.IsNotExist(err) {
-		err := os.Mkdir(uploadDir, os.ModePerm)
-		if err != nil {
+	// the browser showing an HTML preview loads the photo itself, Chrome has no network
+	if format == previewPNG {
+		if err := h.inlinePhoto(c.Request.Context(), &resumeData.Basics); err != nil {
+			var badRequest *errorpkg.ErrBadRequest
+			if errors.As(err, &badRequest) {
+				c.JSON(http.StatusBadRequest, models.Error{
+					Message: err.Error(),
+				})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, models.Error{
-				Message: models.InternalMessage,
+				Message: "failed to render preview",
 			})
-			log.Println("Error creating media directory", err.Error())
+			h.Logger.Error("inlinePhoto : " + err.Error())
 			return
 		}
 	}
 
-	id := uuid.New().String()
+	html, err := parser.NewHTMLParser(h.templates).ParseToHtml(resumeData)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: "failed to render preview",
+		})
+		h.Logger.Error("ParseToHtml : " + err.Error())
+		return
+	}
+
+	// previews change on every keystroke, nothing in between should keep them
+	c.Header("Cache-Control", "no-store")
 
-	newFilename := id + ext
-	uploadPath := filepath.Join(uploadDir, newFilename)
+	if format == previewHTML {
+		// the browser showing the preview loads the bundled fonts from the API
+		c.Data(http.StatusOK, "text/html; charset=utf-8", bytes.ReplaceAll(html, []byte(fonts.Origin), []byte(fontsPath)))
+		return
+	}
 
-	if err := c.SaveUploadedFile(file.File, uploadPath); err != nil {
+	png, err := pdf.NewPDFGenerator(h.pdfPool).ScreenshotFromHTML(c.Request.Context(), html, pageOptions)
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: err.Error(),
+			Message: "failed to render preview",
 		})
-		log.Println(err)
+		h.Logger.Error("ScreenshotFromHTML : " + err.Error())
 		return
 	}
 
-	objectName := newFilename
-	contentType := "image/jpeg"
-	_, err = minioClient.FPutObject(context.Background(), bucketName, objectName, uploadPath, minio.PutObjectOptions{
-		ContentType: contentType,
-	})
+	c.Data(http.StatusOK, "image/png", png)
+}
+
+// resumeFromRequest copies the resume fields of a generate request.
+func resumeFromRequest(body models.ResumeGenetare) models.Resume {
+	return models.Resume{
+		Basics:         body.Basics,
+		Work:           body.Work,
+		Projects:       body.Projects,
+		Education:      body.Education,
+		Certificates:   body.Certificates,
+		Skills:         body.Skills,
+		SoftSkills:     body.SoftSkills,
+		Languages:      body.Languages,
+		Interests:      body.Interests,
+		Volunteer:      body.Volunteer,
+		Awards:         body.Awards,
+		Publications:   body.Publications,
+		References:     body.References,
+		CustomSections: body.CustomSections,
+		Meta:           body.Meta,
+		Labels:         body.Labels,
+	}
+}
+
+// resumeLang returns meta.lang, or the language the client accepts best when the
+// resume does not set one.
+func resumeLang(c *gin.Context, metaLang string) string {
+	if metaLang != "" {
+		return metaLang
+	}
+	return lang.FromAcceptLanguage(c.GetHeader("Accept-Language"))
+}
 
+// UploadResumePhoto
+// @Summary     Upload Resume Photo
+// @Security    BearerAuth
+// @Description Through this api front-ent can upload resume photo and get the link to the resume. The photo is checked from its content, turned upright, stripped of its EXIF metadata and cropped into square JPEG variants, minio_url is the one printed on resumes.
+// @Tags        MEDIA
+// @Accept      json
+// @Produce     json
+// @Param       file formData file true "JPEG, PNG or WebP image"
+// @Success     200 {object} models.ResponseUrl
+// @Failure     400 {object} models.Error
+// @Failure     500 {object} models.Error
+// @Router      /v1/resume/resume-photo [POST]
+func (h *HandlerV1) UploadResumePhoto(c *gin.Context) {
+	file := &models.File{}
+	err := c.ShouldBind(&file)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.Error{
+		c.JSON(http.StatusBadRequest, models.Error{
 			Message: err.Error(),
 		})
-		log.Println(err)
+		log.Println(err.Error())
 		return
 	}
 
-	minioURL := fmt.Sprintf("https://media.cvmaker.uz/%s/%s", bucketName, objectName)
+	urls, err := storePhoto(file.File, c, h.Config)
+	if err != nil {
+		var badRequest *errorpkg.ErrBadRequest
+		if errors.As(err, &badRequest) {
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("storePhoto : " + err.Error())
+		return
+	}
 
 	c.JSON(http.StatusOK, &models.ResponseUrl{
-		MinioUrl: minioURL,
-		Path:     "34.89.185.96/projects/go/resume_maker/api-service/" + uploadPath,
+		MinioUrl: urls[photo.VariantTemplate],
+		Path:     "34.89.185.96/projects/go/resume_maker/api-service/" + filepath.Join(uploadDir, path.Base(urls[photo.VariantTemplate])),
+		Variants: urls,
 	})
 }
 
@@ -664,6 +1012,7 @@ func (h *HandlerV1) ListUserResume(c *gin.Context) {
 		resRes.Salary = val.Salary
 		resRes.JobLocation = val.JobLocation
 		resRes.Experiance = int32(val.Experience)
+		resRes.Watermark = val.Watermark
 
 		resumes = append(resumes, &resRes)
 	}
@@ -786,6 +1135,7 @@ func (h *HandlerV1) ListResume(c *gin.Context) {
 		resRes.Salary = val.Salary
 		resRes.JobLocation = val.JobLocation
 		resRes.Experiance = int32(val.Experience)
+		resRes.Watermark = val.Watermark
 
 		resumes.Resumes = append(resumes.Resumes, resRes)
 	}
@@ -855,6 +1205,199 @@ func (h *HandlerV1) DeleteResume(c *gin.Context) {
 	})
 }
 
+// ImportJSONResume
+// @Security 		BearerAuth
+// @Summary 		Import a JSON Resume
+// @Description 	This API validates a JSON Resume v1 document (jsonresume.org) and maps it onto a resume ready for generation
+// @Tags 			RESUME
+// @Accept			json
+// @Produce 		json
+// @Param 			data body jsonresume.Document true "JSON Resume document"
+// @Success 		200 {object} models.Resume
+// @Failure 		400 {object} models.ValidationError
+// @Failure 		401 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/resume/import [POST]
+func (h *HandlerV1) ImportJSONResume(c *gin.Context) {
+	data, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: models.WrongInfoMessage,
+		})
+		return
+	}
+
+	resumeData, err := jsonresume.Import(data)
+	if err != nil {
+		var errValidation *errorpkg.ErrValidation
+		var badRequest *errorpkg.ErrBadRequest
+		switch {
+		case errors.As(err, &errValidation):
+			c.JSON(http.StatusBadRequest, models.ValidationError{
+				Message: errValidation.Error(),
+				Errors:  errValidation.Errors,
+			})
+		case errors.As(err, &badRequest):
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: badRequest.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.Error{
+				Message: models.InternalMessage,
+			})
+			h.Logger.Error("failed to import JSON Resume", l.Error(err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resumeData)
+}
+
+// ImportLinkedIn
+// @Security 		BearerAuth
+// @Summary 		Import a LinkedIn data export
+// @Description 	This API reads the ZIP archive of a LinkedIn data export and returns a resume draft for the step wizard with the rows that could not be mapped
+// @Tags 			STEP-RESUME
+// @Accept			multipart/form-data
+// @Produce 		json
+// @Param 			file formData file true "LinkedIn export archive"
+// @Success 		200 {object} models.LinkedInImportRes
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		413 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/resume/import/linkedin [POST]
+func (h *HandlerV1) ImportLinkedIn(c *gin.Context) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: models.WrongInfoMessage,
+		})
+		return
+	}
+
+	if file.Size > linkedin.MaxArchiveSize {
+		c.JSON(http.StatusRequestEntityTooLarge, models.Error{
+			Message: fmt.Sprintf("archive must not exceed %d MB", linkedin.MaxArchiveSize>>20),
+		})
+		return
+	}
+
+	archiveFile, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("failed to open LinkedIn archive", l.Error(err))
+		return
+	}
+	defer archiveFile.Close()
+
+	archive, err := io.ReadAll(io.LimitReader(archiveFile, linkedin.MaxArchiveSize))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("failed to read LinkedIn archive", l.Error(err))
+		return
+	}
+
+	resumeData, issues, err := linkedin.Import(archive)
+	if err != nil {
+		var badRequest *errorpkg.ErrBadRequest
+		if errors.As(err, &badRequest) {
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("failed to import LinkedIn archive", l.Error(err))
+		return
+	}
+
+	if issues == nil {
+		issues = []models.ImportIssue{}
+	}
+
+	c.JSON(http.StatusOK, models.LinkedInImportRes{
+		Resume:   resumeData,
+		Unmapped: issues,
+	})
+}
+
+// ExportJSONResume
+// @Security 		BearerAuth
+// @Summary 		Export a resume as JSON Resume
+// @Description 	This API returns a stored resume of the user as a JSON Resume v1 document
+// @Tags 			RESUME
+// @Produce 		json
+// @Param 			id path string true "Resume ID"
+// @Success 		200 {object} jsonresume.Document
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		404 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/resumes/{id}/export [GET]
+func (h *HandlerV1) ExportJSONResume(c *gin.Context) {
+	userID, status := GetIdFromToken(c.Request, h.Config)
+	if status == http.StatusUnauthorized {
+		c.JSON(http.StatusUnauthorized, models.Error{
+			Message: "Token is invalid",
+		})
+		return
+	}
+
+	resume, err := h.Service.ResumeService().GetResumeByID(context.Background(), &resume_service.ResumeWithID{
+		ResumeId: c.Param("id"),
+	})
+	if err != nil || resume == nil {
+		c.JSON(http.StatusNotFound, models.Error{
+			Message: models.NotFoundMessage,
+		})
+		h.Logger.Error("failed to get resume in export", l.Error(err))
+		return
+	}
+
+	if resume.UserId != userID {
+		c.JSON(http.StatusForbidden, models.Error{
+			Message: "resume belongs to another user",
+		})
+		return
+	}
+
+	// resumes generated before the content was stored can not be exported
+	if resume.Content == "" {
+		c.JSON(http.StatusNotFound, models.Error{
+			Message: "resume content is not stored, generate the resume again to export it",
+		})
+		return
+	}
+
+	var resumeData models.Resume
+	if err := json.Unmarshal([]byte(resume.Content), &resumeData); err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("failed to unmarshal stored resume content", l.Error(err))
+		return
+	}
+
+	document, err := jsonresume.Export(resumeData)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("failed to export JSON Resume", l.Error(err))
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="resume.json"`)
+	c.Data(http.StatusOK, "application/json; charset=utf-8", document)
+}
+
 func formatMessage(botProduce models.BotProduce) string {
 	links := ""
 	for _, link := range botProduce.Links {
@@ -865,6 +1408,22 @@ func formatMessage(botProduce models.BotProduce) string {
 }
 
 func GeneratePDFminio(multipartFile *multipart.FileHeader, basicUserName string, c *gin.Context, cfg *config.Config) (string, error) {
+	return storeMinio(multipartFile, resumeObjectName(basicUserName, filepath.Ext(multipartFile.Filename)), c, cfg)
+}
+
+// resumeObjectName is a unique name to store a document of basicUserName under, ext included.
+func resumeObjectName(basicUserName, ext string) string {
+	cvuuid := uuid.NewString()
+	return strings.Join(strings.Split(basicUserName, " "), "") + cvuuid[:5] + "CVMaker" + ext
+}
+
+// minioObjectURL is the public URL of an object of the bucket.
+func minioObjectURL(cfg *config.Config, objectName string) string {
+	return fmt.Sprintf("https://media.cvmaker.uz/%s/%s", cfg.Minio.BucketName, objectName)
+}
+
+// storeMinio uploads multipartFile to the bucket as objectName and returns its public URL.
+func storeMinio(multipartFile *multipart.FileHeader, objectName string, c *gin.Context, cfg *config.Config) (string, error) {
 	// minio
 	endpoint := cfg.Minio.Host + cfg.Minio.Port
 	accessKeyID := cfg.Minio.AccessKey
@@ -929,7 +1488,6 @@ func GeneratePDFminio(multipartFile *multipart.FileHeader, basicUserName string,
 
 	ext := filepath.Ext(file.File.Filename)
 
-	uploadDir := "./media"
 	if _, err := os.Stat(uploadDir); os.IsNotExist(err) {
 		err := os.Mkdir(uploadDir, os.ModePerm)
 		if err != nil {
@@ -938,18 +1496,14 @@ func GeneratePDFminio(multipartFile *multipart.FileHeader, basicUserName string,
 		}
 	}
 
-	cvuuid := uuid.NewString()
-	userFullName := strings.Join(strings.Split(basicUserName, " "), "") + cvuuid[:5] + "CVMaker"
-	newFilename := userFullName + ext
-	uploadPath := filepath.Join(uploadDir, newFilename)
+	uploadPath := filepath.Join(uploadDir, objectName)
 
 	if err := c.SaveUploadedFile(file.File, uploadPath); err != nil {
 		log.Println(err)
 		return "", err
 	}
 
-	objectName := newFilename
-	contentType := "application/pdf"
+	contentType := documentContentTypes[ext]
 	_, err = minioClient.FPutObject(context.Background(), bucketName, objectName, uploadPath, minio.PutObjectOptions{
 		ContentType: contentType,
 	})
@@ -958,8 +1512,6 @@ func GeneratePDFminio(multipartFile *multipart.FileHeader, basicUserName string,
 		return "", err
 	}
 
-	minioURL := fmt.Sprintf("https://media.cvmaker.uz/%s/%s", bucketName, objectName)
-
 	// minio
-	return minioURL, nil
+	return minioObjectURL(cfg, objectName), nil
 }
@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/fonts"
+	l "github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/logger"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
+)
+
+// ListTemplates
+// @Security 		BearerAuth
+// @Summary 		List Resume Templates
+// @Description 	This API lists the resume templates with the sections, languages and paper sizes they support, custom templates of the user included
+// @Tags 			TEMPLATE
+// @Produce 		json
+// @Success 		200 {object} models.TemplateList
+// @Router 			/v1/templates [GET]
+func (h *HandlerV1) ListTemplates(c *gin.Context) {
+	var templates []models.Template
+	for _, manifest := range h.templates.Manifests(templateOwner(c.Request, h.Config)) {
+		templates = append(templates, templateFromManifest(manifest))
+	}
+
+	c.JSON(http.StatusOK, models.TemplateList{
+		Templates: templates,
+		Count:     uint64(len(templates)),
+	})
+}
+
+// UploadTemplate
+// @Security 		BearerAuth
+// @Summary 		Upload a Custom Template
+// @Description 	This API stores a ZIP archive of gohtml partials and CSS as a template only its owner can select in meta.template. The entry file is named _<name>.gohtml, partials are included by file name and resources must be embedded as data URIs
+// @Tags 			TEMPLATE
+// @Accept			multipart/form-data
+// @Produce 		json
+// @Param 			file formData file true "Template archive"
+// @Param 			name formData string true "Display name"
+// @Param 			description formData string false "Description"
+// @Success 		201 {object} models.Template
+// @Failure 		400 {object} models.ValidationError
+// @Failure 		401 {object} models.Error
+// @Failure 		413 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/templates [POST]
+func (h *HandlerV1) UploadTemplate(c *gin.Context) {
+	userID, status := GetIdFromToken(c.Request, h.Config)
+	if status == http.StatusUnauthorized {
+		c.JSON(http.StatusUnauthorized, models.Error{
+			Message: "Token is invalid",
+		})
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: models.WrongInfoMessage,
+		})
+		return
+	}
+
+	if file.Size > template.MaxCustomArchiveSize {
+		c.JSON(http.StatusRequestEntityTooLarge, models.Error{
+			Message: fmt.Sprintf("archive must not exceed %d KB", template.MaxCustomArchiveSize>>10),
+		})
+		return
+	}
+
+	archiveFile, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("failed to open template archive", l.Error(err))
+		return
+	}
+	defer archiveFile.Close()
+
+	archive, err := io.ReadAll(io.LimitReader(archiveFile, template.MaxCustomArchiveSize))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: models.InternalMessage,
+		})
+		h.Logger.Error("failed to read template archive", l.Error(err))
+		return
+	}
+
+	manifest, err := h.templates.AddCustomTemplate(userID, c.PostForm("name"), c.PostForm("description"), archive)
+	if err != nil {
+		var errValidation *errorpkg.ErrValidation
+		var badRequest *errorpkg.ErrBadRequest
+		switch {
+		case errors.As(err, &errValidation):
+			c.JSON(http.StatusBadRequest, models.ValidationError{
+				Message: errValidation.Error(),
+				Errors:  errValidation.Errors,
+			})
+		case errors.As(err, &badRequest):
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: badRequest.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.Error{
+				Message: models.InternalMessage,
+			})
+			h.Logger.Error("failed to store custom template", l.Error(err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, templateFromManifest(manifest))
+}
+
+// GetTemplatePreview
+// @Security 		BearerAuth
+// @Summary 		Get Template Preview
+// @Description 	This API returns the preview image of a resume template
+// @Tags 			TEMPLATE
+// @Produce 		image/svg+xml
+// @Produce 		png
+// @Param 			name path string true "Template name"
+// @Success 		200 {file} file
+// @Failure 		400 {object} models.Error
+// @Failure 		404 {object} models.Error
+// @Router 			/v1/templates/{name}/preview [GET]
+func (h *HandlerV1) GetTemplatePreview(c *gin.Context) {
+	previewPath, err := h.templates.PreviewPath(c.Param("name"))
+	if err != nil {
+		var badRequest *errorpkg.ErrBadRequest
+		if errors.As(err, &badRequest) {
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusNotFound, models.Error{
+			Message: models.NotFoundMessage,
+		})
+		return
+	}
+
+	c.File(previewPath)
+}
+
+// fontsPath serves the bundled fonts to browsers showing an HTML preview.
+const fontsPath = "/v1/fonts/"
+
+// GetFont
+// @Summary 		Get a Bundled Font
+// @Description 	This API serves the fonts embedded in the service, HTML previews load them from here
+// @Tags 			TEMPLATE
+// @Produce 		font/woff2,font/ttf
+// @Param 			file path string true "Font file"
+// @Success 		200 {file} file
+// @Failure 		404 {object} models.Error
+// @Router 			/v1/fonts/{file} [GET]
+func (h *HandlerV1) GetFont(c *gin.Context) {
+	file := c.Param("file")
+	if _, err := fs.Stat(fonts.FS, file); err != nil {
+		c.JSON(http.StatusNotFound, models.Error{
+			Message: models.NotFoundMessage,
+		})
+		return
+	}
+
+	// font files never change within a release
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.FileFromFS(file, http.FS(fonts.FS))
+}
+
+func templateFromManifest(manifest template.Manifest) models.Template {
+	res := models.Template{
+		Name:         manifest.Name,
+		DisplayName:  manifest.DisplayName,
+		Description:  manifest.Description,
+		Sections:     manifest.Sections,
+		Languages:    manifest.Languages,
+		PaperSizes:   manifest.PaperSizes,
+		Orientations: manifest.Orientations,
+		Fonts:        manifest.Fonts,
+		SideSections: manifest.Side,
+		BaseFontSize: manifest.BaseFontSize,
+		Custom:       manifest.Owner != "",
+		CoverLetter:  manifest.Letter != "",
+	}
+	if manifest.Preview != "" {
+		res.PreviewURL = fmt.Sprintf("/v1/templates/%s/preview", manifest.Name)
+	}
+	return res
+}
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/config"
+	tokens "github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/token"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+)
+
+// RateLimiter is an in-memory token bucket per client. It keeps no state outside the
+// process on purpose, so limited endpoints stay free of side effects on shared stores.
+type RateLimiter struct {
+	mu      sync.Mutex
+	limit   float64
+	window  time.Duration
+	buckets map[string]*bucket
+	cfg     config.Config
+	// lastSweep is when idle buckets were last dropped
+	lastSweep time.Time
+	// now is the clock buckets are refilled with
+	now func() time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter allows limit requests per window to every client, bursts included.
+func NewRateLimiter(limit int, window time.Duration, cfg config.Config) *RateLimiter {
+	return &RateLimiter{
+		limit:     float64(limit),
+		window:    window,
+		buckets:   make(map[string]*bucket),
+		cfg:       cfg,
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// Handler rejects requests over the limit with 429 and a Retry-After header.
+func (rl *RateLimiter) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		allowed, retryAfter := rl.allow(rl.clientKey(c), rl.now())
+		if allowed {
+			c.Next()
+			return
+		}
+
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, models.Error{
+			Message: models.RateLimitExceeded,
+		})
+	}
+}
+
+func (rl *RateLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.sweep(now)
+
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &bucket{tokens: rl.limit, last: now}
+		rl.buckets[key] = b
+	}
+
+	// refill proportionally to the time elapsed since the last request
+	perToken := rl.window / time.Duration(rl.limit)
+	b.tokens = math.Min(rl.limit, b.tokens+float64(now.Sub(b.last))/float64(perToken))
+	b.last = now
+
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) * float64(perToken))
+	}
+	b.tokens--
+
+	return true, 0
+}
+
+// sweep drops buckets idle for a whole window, they are full again anyway.
+func (rl *RateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < rl.window {
+		return
+	}
+	for key, b := range rl.buckets {
+		if now.Sub(b.last) >= rl.window {
+			delete(rl.buckets, key)
+		}
+	}
+	rl.lastSweep = now
+}
+
+// clientKey identifies signed in users by their token subject and others by IP.
+func (rl *RateLimiter) clientKey(c *gin.Context) string {
+	token := strings.TrimPrefix(c.Request.Header.Get("Authorization"), "Bearer ")
+	if token != "" {
+		if claims, err := tokens.ExtractClaim(token, []byte(rl.cfg.Token.SignInKey)); err == nil {
+			if sub := cast.ToString(claims["sub"]); sub != "" {
+				return "user:" + sub
+			}
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
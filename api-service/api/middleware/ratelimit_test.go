@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/config"
+	tokens "github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/token"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const signInKey = "test-key"
+
+// testLimiter serves a limited endpoint, the clock only moves when advance is called.
+func testLimiter(limit int, window time.Duration) (router *gin.Engine, advance func(time.Duration)) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := config.Config{}
+	cfg.Token.SignInKey = signInKey
+	rl := NewRateLimiter(limit, window, cfg)
+	now := time.Date(2026, time.March, 4, 10, 0, 0, 0, time.UTC)
+	rl.now = func() time.Time { return now }
+	rl.lastSweep = now
+
+	router = gin.New()
+	router.GET("/preview", rl.Handler(), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	return router, func(d time.Duration) { now = now.Add(d) }
+}
+
+func request(t *testing.T, router *gin.Engine, ip, token string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/preview", nil)
+	req.RemoteAddr = ip + ":41000"
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func userToken(t *testing.T, sub string) string {
+	t.Helper()
+
+	access, _, err := (&tokens.JwtHandler{Sub: sub, SigninKey: signInKey, Log: zap.NewNop()}).GenerateJwt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return access
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	router, _ := testLimiter(3, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if w := request(t, router, "10.0.0.1", ""); w.Code != http.StatusOK {
+			t.Fatalf("request %d = %d, want the burst allowed", i+1, w.Code)
+		}
+	}
+
+	w := request(t, router, "10.0.0.1", "")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("request over the limit = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+	// one token comes back every 20 seconds
+	if got := w.Header().Get("Retry-After"); got != "20" {
+		t.Errorf("Retry-After = %q, want 20", got)
+	}
+	var body models.Error
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil || body.Message != models.RateLimitExceeded {
+		t.Errorf("body = %s, want a models.Error", w.Body)
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	router, advance := testLimiter(3, time.Minute)
+	for i := 0; i < 3; i++ {
+		request(t, router, "10.0.0.1", "")
+	}
+
+	advance(10 * time.Second)
+	w := request(t, router, "10.0.0.1", "")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("request after half a token = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+	if got := w.Header().Get("Retry-After"); got != "10" {
+		t.Errorf("Retry-After = %q, want the 10 seconds left", got)
+	}
+
+	advance(10 * time.Second)
+	if w := request(t, router, "10.0.0.1", ""); w.Code != http.StatusOK {
+		t.Fatalf("request after a refilled token = %d, want %d", w.Code, http.StatusOK)
+	}
+	if w := request(t, router, "10.0.0.1", ""); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request after a refilled token = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+
+	// an idle client gets the whole burst back, never more
+	advance(time.Hour)
+	for i := 0; i < 3; i++ {
+		if w := request(t, router, "10.0.0.1", ""); w.Code != http.StatusOK {
+			t.Fatalf("request %d after an hour = %d, want the burst allowed", i+1, w.Code)
+		}
+	}
+	if w := request(t, router, "10.0.0.1", ""); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("request over the refilled burst = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+}
+
+func TestRateLimiterIsolatesClients(t *testing.T) {
+	router, _ := testLimiter(1, time.Minute)
+	alice, bob := userToken(t, "alice"), userToken(t, "bob")
+
+	if w := request(t, router, "10.0.0.1", alice); w.Code != http.StatusOK {
+		t.Fatalf("first request of alice = %d", w.Code)
+	}
+	if w := request(t, router, "10.0.0.1", alice); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request of alice = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+
+	// users behind the same address have their own bucket, so do anonymous clients
+	if w := request(t, router, "10.0.0.1", bob); w.Code != http.StatusOK {
+		t.Errorf("first request of bob = %d, want %d", w.Code, http.StatusOK)
+	}
+	if w := request(t, router, "10.0.0.1", ""); w.Code != http.StatusOK {
+		t.Errorf("anonymous request = %d, want %d", w.Code, http.StatusOK)
+	}
+	if w := request(t, router, "10.0.0.2", ""); w.Code != http.StatusOK {
+		t.Errorf("request from another address = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	// a token that does not verify falls back to the address
+	if w := request(t, router, "10.0.0.2", "forged"); w.Code != http.StatusTooManyRequests {
+		t.Errorf("request with a forged token = %d, want the limit of its address", w.Code)
+	}
+}
+
+func TestRateLimiterSweepsIdleBuckets(t *testing.T) {
+	cfg := config.Config{}
+	rl := NewRateLimiter(1, time.Minute, cfg)
+	start := time.Now()
+
+	rl.allow("ip:10.0.0.1", start)
+	rl.allow("ip:10.0.0.2", start.Add(50*time.Second))
+	rl.allow("ip:10.0.0.3", start.Add(90*time.Second))
+
+	if _, ok := rl.buckets["ip:10.0.0.1"]; ok {
+		t.Error("the bucket idle for a window was kept")
+	}
+	if _, ok := rl.buckets["ip:10.0.0.2"]; !ok {
+		t.Error("the recently used bucket was dropped")
+	}
+}
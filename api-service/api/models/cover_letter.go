@@ -0,0 +1,63 @@
+package models
+
+import "github.com/dostonshernazarov/resume_maker/api-service/internal/utils/lang"
+
+const (
+	GreetingLabel  = "GreetingLabel"
+	GreetingFormat = "GreetingFormat"
+	ClosingLabel   = "ClosingLabel"
+
+	// MaxLetterParagraphs bounds the body of a cover letter, it is meant to fit on one page
+	MaxLetterParagraphs = 10
+	// MaxLetterFieldLen is the width in characters of the recipient and company columns
+	MaxLetterFieldLen = 100
+)
+
+// CoverLetter is sent with a resume, it is written with the basics of the resume and
+// rendered with the matching layout of the template in meta.
+type CoverLetter struct {
+	Basics    Basics `json:"basics"`
+	Recipient string `json:"recipient" example:"Jane Doe"`
+	Company   string `json:"company" example:"Acme Inc."`
+	// Date is written on the letter as YYYY-MM-DD, the day it is generated when empty
+	Date       string            `json:"date" example:"2024-06-15"`
+	Paragraphs []string          `json:"paragraphs"`
+	Meta       Meta              `json:"meta"`
+	Labels     CoverLetterLabels `json:"-"`
+}
+
+type CoverLetterLabels struct {
+	Greeting string
+	Closing  string
+}
+
+// CoverLetterRes is a stored cover letter, Letter is only returned for a single one.
+type CoverLetterRes struct {
+	ID        string       `json:"id"`
+	URL       string       `json:"url"`
+	Template  string       `json:"template"`
+	Recipient string       `json:"recipient"`
+	Company   string       `json:"company"`
+	Date      string       `json:"date"`
+	CreatedAt string       `json:"created_at"`
+	Letter    *CoverLetter `json:"letter,omitempty"`
+}
+
+type CoverLetterList struct {
+	CoverLetters []CoverLetterRes `json:"cover_letters"`
+	Count        uint64           `json:"count"`
+}
+
+// GetGreetingLabel addresses the recipient, or the hiring manager when there is none.
+func (l *CoverLetter) GetGreetingLabel() string {
+	if l.Recipient == "" {
+		return lang.Translate(l.Meta.Lang, GreetingLabel)
+	}
+	return lang.TranslateData(l.Meta.Lang, GreetingFormat, map[string]interface{}{
+		"Recipient": l.Recipient,
+	})
+}
+
+func (l *CoverLetter) GetClosingLabel() string {
+	return lang.Translate(l.Meta.Lang, ClosingLabel)
+}
@@ -5,6 +5,12 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// ValidationError lists the invalid fields of a request, keyed by their path.
+type ValidationError struct {
+	Message string            `json:"message"`
+	Errors  map[string]string `json:"errors"`
+}
+
 const (
 	WrongDateMessage = "Incorrect Date"
 	WrongInfoMessage = "Incorrect Data"
@@ -21,4 +27,6 @@ const (
 	EmailAlreadyInUse = "Email already in use, please use another email address"
 
 	TokenExpired = "Token expired"
+
+	RateLimitExceeded = "Rate limit exceeded, try again later"
 )
@@ -10,6 +10,8 @@ type (
 	ResponseUrl struct {
 		MinioUrl string `json:"minio_url"`
 		Path     string `json:"path"`
+		// Variants are the URLs of every size of the photo, keyed by variant
+		Variants map[string]string `json:"variants"`
 	}
 
 	UploadPhotoRes struct {
@@ -1,17 +1,105 @@
 package models
 
-import "github.com/dostonshernazarov/resume_maker/api-service/internal/utils/lang"
+import (
+	"html/template"
 
-const (
-	OutputDir      = "output"
-	OutputHtmlFile = "output/resume.html"
-	OutputPdfFile  = "output/resume.pdf"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/lang"
 )
 
 const (
 	ClassicTemplate = "classic"
 )
 
+// Sections of a resume, named after the properties of Resume.
+const (
+	SectionBasics       = "basics"
+	SectionWork         = "work"
+	SectionProjects     = "projects"
+	SectionEducation    = "education"
+	SectionCertificates = "certificates"
+	SectionSkills       = "skills"
+	SectionSoftSkills   = "softSkills"
+	SectionLanguages    = "languages"
+	SectionInterests    = "interests"
+	SectionVolunteer    = "volunteer"
+	SectionAwards       = "awards"
+	SectionPublications = "publications"
+	SectionReferences   = "references"
+	SectionCustom       = "customSections"
+)
+
+// Sections lists every section a template can render.
+var Sections = []string{
+	SectionBasics,
+	SectionWork,
+	SectionProjects,
+	SectionEducation,
+	SectionCertificates,
+	SectionSkills,
+	SectionSoftSkills,
+	SectionLanguages,
+	SectionInterests,
+	SectionVolunteer,
+	SectionAwards,
+	SectionPublications,
+	SectionReferences,
+	SectionCustom,
+}
+
+const (
+	OutputPDF      = "pdf"
+	OutputDOCX     = "docx"
+	OutputText     = "txt"
+	OutputMarkdown = "md"
+)
+
+const (
+	PaperA4     = "A4"
+	PaperA5     = "A5"
+	PaperLetter = "Letter"
+	PaperLegal  = "Legal"
+
+	OrientationPortrait  = "portrait"
+	OrientationLandscape = "landscape"
+
+	// MinHeaderMargin keeps room in inches for the header and footer Chrome draws inside the margins
+	MinHeaderMargin = 0.4
+)
+
+// Columns of two column templates.
+const (
+	ColumnMain = "main"
+	ColumnSide = "side"
+)
+
+// Profiles of the PDF, the default one has no archival constraint.
+const (
+	PDFProfileDefault = ""
+	PDFProfileA2B     = "pdfa-2b"
+)
+
+// Links the QR code of the contact block points at, there is no code by default.
+const (
+	QRTargetNone    = ""
+	QRTargetURL     = "url"
+	QRTargetProfile = "profile"
+	QRTargetResume  = "resume"
+)
+
+const (
+	DensityCompact = "compact"
+	DensityNormal  = "normal"
+	DensityRelaxed = "relaxed"
+)
+
+// paperSizes holds width and height in inches of the supported presets in portrait.
+var paperSizes = map[string][2]float64{
+	PaperA4:     {8.27, 11.69},
+	PaperA5:     {5.83, 8.27},
+	PaperLetter: {8.5, 11},
+	PaperLegal:  {8.5, 14},
+}
+
 const (
 	EducationLabel   = "EducationLabel"
 	ExperiencesLabel = "ExperiencesLabel"
@@ -22,6 +110,12 @@ const (
 	InterestsLabel   = "InterestsLabel"
 	ProfileLabel     = "ProfileLabel"
 	SinceLabel       = "SinceLabel"
+
+	CertificationsLabel = "CertificationsLabel"
+	VolunteerLabel      = "VolunteerLabel"
+	AwardsLabel         = "AwardsLabel"
+	PublicationsLabel   = "PublicationsLabel"
+	ReferencesLabel     = "ReferencesLabel"
 )
 
 type Filter struct {
@@ -43,8 +137,14 @@ type Resume struct {
 	SoftSkills   []Skill       `json:"softSkills"`
 	Languages    []Language    `json:"languages"`
 	Interests    []Interest    `json:"interests"`
-	Meta         Meta          `json:"meta"`
-	Labels       ResumeLabels
+	Volunteer    []Volunteer   `json:"volunteer"`
+	Awards       []Award       `json:"awards"`
+	Publications []Publication `json:"publications"`
+	References   []Reference   `json:"references"`
+	// CustomSections are the sections titled by the user
+	CustomSections []CustomSection `json:"customSections"`
+	Meta           Meta            `json:"meta"`
+	Labels         ResumeLabels
 }
 
 type ResumeGenetare struct {
@@ -57,10 +157,16 @@ type ResumeGenetare struct {
 	SoftSkills   []Skill       `json:"softSkills"`
 	Languages    []Language    `json:"languages"`
 	Interests    []Interest    `json:"interests"`
-	Meta         Meta          `json:"meta"`
-	Labels       ResumeLabels
-	Salary       uint64 `json:"salary"`
-	JobLocation  string `json:"job_location" example:"offline"`
+	Volunteer    []Volunteer   `json:"volunteer"`
+	Awards       []Award       `json:"awards"`
+	Publications []Publication `json:"publications"`
+	References   []Reference   `json:"references"`
+	// CustomSections are the sections titled by the user
+	CustomSections []CustomSection `json:"customSections"`
+	Meta           Meta            `json:"meta"`
+	Labels         ResumeLabels
+	Salary         uint64 `json:"salary"`
+	JobLocation    string `json:"job_location" example:"offline"`
 }
 
 type LastResumeReq struct {
@@ -68,9 +174,14 @@ type LastResumeReq struct {
 	Skills       []Skill       `json:"skills"`
 	Languages    []Language    `json:"languages"`
 	Interests    []Interest    `json:"interests"`
-	Meta         Meta          `json:"meta"`
-	BasicRedisID string        `json:"basic_redis_id"`
-	MainRedisID  string        `json:"main_redis_id"`
+	Awards       []Award       `json:"awards"`
+	Publications []Publication `json:"publications"`
+	References   []Reference   `json:"references"`
+	// CustomSections are the sections titled by the user
+	CustomSections []CustomSection `json:"customSections"`
+	Meta           Meta            `json:"meta"`
+	BasicRedisID   string          `json:"basic_redis_id"`
+	MainRedisID    string          `json:"main_redis_id"`
 }
 
 type Basics struct {
@@ -87,6 +198,8 @@ type Basics struct {
 	JobLocation    string    `json:"job_location"`
 	JobType        string    `json:"job_type" example:"full-time"`
 	ExperienceYear int32     `json:"experience_year"`
+	// QRCode is the inline SVG the templates print in the contact block, set from meta.qr
+	QRCode template.HTML `json:"-"`
 }
 
 type ResumeBot struct {
@@ -117,6 +230,8 @@ type BotProduce struct {
 }
 
 type Location struct {
+	Address     string `json:"address"`
+	PostalCode  string `json:"postalCode"`
 	City        string `json:"city"`
 	CountryCode string `json:"countryCode"`
 	Region      string `json:"region"`
@@ -131,22 +246,33 @@ type Profile struct {
 type Work struct {
 	Position     string   `json:"position"`
 	Company      string   `json:"company"`
+	URL          string   `json:"url"`
+	Description  string   `json:"description"`
 	StartDate    string   `json:"startDate"`
 	EndDate      string   `json:"endDate"`
 	Summary      string   `json:"summary"`
+	Highlights   []string `json:"highlights"`
 	Location     string   `json:"location"`
 	Skills       []string `json:"skills"`
 	ContractType string   `json:"contract_type"`
 }
 
 type Project struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	URL         string `json:"url"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	URL         string   `json:"url"`
+	Highlights  []string `json:"highlights"`
+	Keywords    []string `json:"keywords"`
+	StartDate   string   `json:"startDate"`
+	EndDate     string   `json:"endDate"`
+	Roles       []string `json:"roles"`
+	Entity      string   `json:"entity"`
+	Type        string   `json:"type"`
 }
 
 type Education struct {
 	Institution string   `json:"institution"`
+	URL         string   `json:"url"`
 	Area        string   `json:"area"`
 	StudyType   string   `json:"studyType"`
 	Location    string   `json:"location"`
@@ -158,12 +284,29 @@ type Education struct {
 
 type ResumeResponse struct {
 	Resume string `json:"resume"`
+	// Files holds the URL of every requested output format, keyed by format
+	Files map[string]string `json:"files,omitempty"`
+}
+
+// ImportIssue reports a row of an imported file that could not be mapped onto the resume.
+type ImportIssue struct {
+	File   string `json:"file"`
+	Line   int    `json:"line,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// LinkedInImportRes is a resume draft, its basics feed /v1/resume/basic and its
+// work, projects and education feed /v1/resume/main.
+type LinkedInImportRes struct {
+	Resume   Resume        `json:"resume"`
+	Unmapped []ImportIssue `json:"unmapped"`
 }
 
 type MainResumeReq struct {
 	Work         []Work      `json:"work"`
 	Projects     []Project   `json:"projects"`
 	Education    []Education `json:"education"`
+	Volunteer    []Volunteer `json:"volunteer"`
 	BasicRedisID string      `json:"basic_redis_id"`
 	MainRedisID  string      `json:"main_redis_id"`
 }
@@ -192,9 +335,120 @@ type Interest struct {
 	Keywords []string `json:"keywords"`
 }
 
+type Volunteer struct {
+	Organization string   `json:"organization"`
+	Position     string   `json:"position"`
+	URL          string   `json:"url"`
+	StartDate    string   `json:"startDate"`
+	EndDate      string   `json:"endDate"`
+	Summary      string   `json:"summary"`
+	Highlights   []string `json:"highlights"`
+}
+
+type Award struct {
+	Title   string `json:"title"`
+	Date    string `json:"date"`
+	Awarder string `json:"awarder"`
+	Summary string `json:"summary"`
+}
+
+type Publication struct {
+	Name        string `json:"name"`
+	Publisher   string `json:"publisher"`
+	ReleaseDate string `json:"releaseDate"`
+	URL         string `json:"url"`
+	Summary     string `json:"summary"`
+}
+
+type Reference struct {
+	Name      string `json:"name"`
+	Reference string `json:"reference"`
+}
+
+// CustomSection is a section the templates do not know, like talks or patents,
+// rendered under the title given by the user.
+type CustomSection struct {
+	Title   string        `json:"title" example:"Talks"`
+	Entries []CustomEntry `json:"entries"`
+}
+
+// CustomEntry is an item of a custom section, every field is optional.
+type CustomEntry struct {
+	Title      string   `json:"title" example:"Scaling Go services"`
+	Subtitle   string   `json:"subtitle" example:"GopherCon EU"`
+	Location   string   `json:"location" example:"Berlin"`
+	URL        string   `json:"url"`
+	StartDate  string   `json:"startDate" example:"2023-06"`
+	EndDate    string   `json:"endDate"`
+	Summary    string   `json:"summary"`
+	Highlights []string `json:"highlights"`
+}
+
 type Meta struct {
-	Template string `json:"template"`
-	Lang     string `json:"lang"`
+	Template string        `json:"template"`
+	Lang     string        `json:"lang"`
+	Page     PageLayout    `json:"page"`
+	Theme    Theme         `json:"theme"`
+	Layout   SectionLayout `json:"layout"`
+	PDF      PDFOptions    `json:"pdf"`
+	QR       QROptions     `json:"qr"`
+	// Canonical, Version and LastModified come from imported JSON Resume documents
+	Canonical    string `json:"canonical"`
+	Version      string `json:"version"`
+	LastModified string `json:"lastModified"`
+}
+
+// PageLayout describes the printed page. Header and footer are plain text where
+// {page}, {pages}, {name} and {date} are replaced when the PDF is printed.
+type PageLayout struct {
+	Size        string      `json:"size" example:"A4"`
+	Orientation string      `json:"orientation" example:"portrait"`
+	Margins     PageMargins `json:"margins"`
+	Header      string      `json:"header" example:"{name}"`
+	Footer      string      `json:"footer" example:"Page {page} of {pages}"`
+	// Draft is set for review copies, their generation time is stamped in the footer
+	Draft bool `json:"-"`
+}
+
+// Theme personalizes the colors and typography of a template, empty values keep its defaults.
+// FontSize is the size of body text in pixels, the other sizes of the template scale with it.
+type Theme struct {
+	AccentColor string  `json:"accentColor" example:"#1F4E79"`
+	FontFamily  string  `json:"fontFamily" example:"Open Sans"`
+	FontSize    float64 `json:"fontSize" example:"13"`
+	Density     string  `json:"density" example:"normal"`
+}
+
+// SectionLayout arranges the sections of the template. Order lists the sections rendered
+// first, the others follow in the order of the template, and Columns moves sections between
+// the main and the side column of two column templates. Basics always stay on top.
+type SectionLayout struct {
+	Order   []string          `json:"order" example:"education,work"`
+	Hidden  []string          `json:"hidden" example:"interests"`
+	Columns map[string]string `json:"columns"`
+}
+
+// PDFOptions choose how the PDF is written. Tagged adds the document structure screen
+// readers follow, Profile makes an archival document as some job portals require.
+type PDFOptions struct {
+	Tagged  bool   `json:"tagged"`
+	Profile string `json:"profile" example:"pdfa-2b"`
+}
+
+// QROptions print a QR code in the contact block, it brings the link back to printed
+// resumes. Target is url for basics.url, profile for the profile of Network or resume
+// for the stored resume itself.
+type QROptions struct {
+	Target  string `json:"target" example:"profile"`
+	Network string `json:"network" example:"LinkedIn"`
+}
+
+// PageMargins are expressed in inches.
+type PageMargins struct {
+	Top    float64 `json:"top"`
+	Right  float64 `json:"right"`
+	Bottom float64 `json:"bottom"`
+	Left   float64 `json:"left"`
 }
 
 type ResumeLabels struct {
@@ -207,6 +461,12 @@ type ResumeLabels struct {
 	Interests   string
 	Profile     string
 	Since       string
+
+	Certifications string
+	Volunteer      string
+	Awards         string
+	Publications   string
+	References     string
 }
 
 type ResResume struct {
@@ -218,6 +478,8 @@ type ResResume struct {
 	Salary      uint64 `json:"salary"`
 	JobLocation string `json:"job_location"`
 	Experiance  int32  `json:"experiance_year"`
+	// Watermark is the text printed across a draft, empty for the final document
+	Watermark string `json:"watermark,omitempty"`
 }
 
 type ResResumeList struct {
@@ -225,6 +487,42 @@ type ResResumeList struct {
 	Count   uint64      `json:"count"`
 }
 
+// WithDefaults fills an empty size and orientation with A4 portrait.
+func (l PageLayout) WithDefaults() PageLayout {
+	if l.Size == "" {
+		l.Size = PaperA4
+	}
+	if l.Orientation == "" {
+		l.Orientation = OrientationPortrait
+	}
+	return l
+}
+
+// Dimensions returns the page width and height in inches, swapped for landscape.
+func (l PageLayout) Dimensions() (width, height float64, ok bool) {
+	size, ok := paperSizes[l.Size]
+	if !ok {
+		return 0, 0, false
+	}
+	if l.Orientation == OrientationLandscape {
+		return size[1], size[0], true
+	}
+	return size[0], size[1], true
+}
+
+// PrintMargins returns the margins the page is printed with, they keep room for the
+// header, the footer and the stamp of a draft.
+func (l PageLayout) PrintMargins() PageMargins {
+	margins := l.Margins
+	if l.Header != "" && margins.Top < MinHeaderMargin {
+		margins.Top = MinHeaderMargin
+	}
+	if (l.Footer != "" || l.Draft) && margins.Bottom < MinHeaderMargin {
+		margins.Bottom = MinHeaderMargin
+	}
+	return margins
+}
+
 func (r *Resume) GetEducationLabel() string {
 	return lang.Translate(r.Meta.Lang, EducationLabel)
 }
@@ -260,3 +558,23 @@ func (r *Resume) GetProfileLabel() string {
 func (r *Resume) GetSinceLabel() string {
 	return lang.Translate(r.Meta.Lang, SinceLabel)
 }
+
+func (r *Resume) GetCertificationsLabel() string {
+	return lang.Translate(r.Meta.Lang, CertificationsLabel)
+}
+
+func (r *Resume) GetVolunteerLabel() string {
+	return lang.Translate(r.Meta.Lang, VolunteerLabel)
+}
+
+func (r *Resume) GetAwardsLabel() string {
+	return lang.Translate(r.Meta.Lang, AwardsLabel)
+}
+
+func (r *Resume) GetPublicationsLabel() string {
+	return lang.Translate(r.Meta.Lang, PublicationsLabel)
+}
+
+func (r *Resume) GetReferencesLabel() string {
+	return lang.Translate(r.Meta.Lang, ReferencesLabel)
+}
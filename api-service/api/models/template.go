@@ -0,0 +1,28 @@
+package models
+
+// Template describes a resume template and what it supports.
+type Template struct {
+	Name         string   `json:"name" example:"classic"`
+	DisplayName  string   `json:"display_name" example:"Classic"`
+	Description  string   `json:"description"`
+	Sections     []string `json:"sections" example:"basics,work,education"`
+	Languages    []string `json:"languages" example:"en,fr"`
+	PaperSizes   []string `json:"paper_sizes" example:"A4,Letter"`
+	Orientations []string `json:"orientations" example:"portrait"`
+	// SideSections are in the side column by default, empty for single column templates
+	SideSections []string `json:"side_sections" example:"education,skills"`
+	// Fonts are the bundled font families of the template, meta.theme.fontFamily replaces them
+	Fonts []string `json:"fonts" example:"Fira Sans,Open Sans"`
+	// BaseFontSize is the body text size in pixels, meta.theme.fontSize replaces it
+	BaseFontSize float64 `json:"base_font_size" example:"14"`
+	PreviewURL   string  `json:"preview_url,omitempty" example:"/v1/templates/classic/preview"`
+	// Custom templates were uploaded by the user and only they can use them
+	Custom bool `json:"custom"`
+	// CoverLetter tells whether cover letters can be rendered with the template
+	CoverLetter bool `json:"cover_letter"`
+}
+
+type TemplateList struct {
+	Templates []Template `json:"templates"`
+	Count     uint64     `json:"count"`
+}
@@ -22,6 +22,8 @@ import (
 
 	grpcClients "github.com/dostonshernazarov/resume_maker/api-service/internal/infrastructure/grpc_service_client"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/config"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/pdf"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
 	tokens "github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/token"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/usecase/app_version"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/usecase/event"
@@ -39,6 +41,8 @@ type RouteOption struct {
 	Enforcer       *casbin.Enforcer
 	Cache          repo.Cache
 	Writer         *rabbitmq.RabbitMQProducerImpl
+	PdfPool        *pdf.Pool
+	Templates      *template.Manager
 }
 
 // NewRouter
@@ -65,6 +69,8 @@ func NewRoute(option RouteOption) *gin.Engine {
 		Enforcer:       option.Enforcer,
 		Redis:          option.Cache,
 		Writer:         option.Writer,
+		PdfPool:        option.PdfPool,
+		Templates:      option.Templates,
 	})
 
 	corsConfig := cors.DefaultConfig()
@@ -78,6 +84,8 @@ func NewRoute(option RouteOption) *gin.Engine {
 	// router.Use(middleware.Tracing)
 	router.Use(middleware.CheckCasbinPermission(option.Enforcer, *option.Config))
 
+	previewLimiter := middleware.NewRateLimiter(option.Config.Preview.RateLimit, option.Config.Preview.RateWindow, *option.Config)
+
 	router.Static("/media", "./media")
 	api := router.Group("/v1")
 
@@ -104,14 +112,30 @@ func NewRoute(option RouteOption) *gin.Engine {
 
 	// RESUME
 	api.POST("/resume/generate-resume", HandlerV1.GenerateResume)
+	api.POST("/resume/preview", previewLimiter.Handler(), HandlerV1.PreviewResume)
 	api.GET("/users/resume/list", HandlerV1.ListUserResume)
 	api.GET("/resume/list", HandlerV1.ListResume)
 	api.DELETE("/resumes/:id", HandlerV1.DeleteResume)
+	api.POST("/resume/import", HandlerV1.ImportJSONResume)
+	api.GET("/resumes/:id/export", HandlerV1.ExportJSONResume)
+
+	// COVER-LETTER
+	api.POST("/cover-letters", HandlerV1.GenerateCoverLetter)
+	api.GET("/cover-letters", HandlerV1.ListCoverLetters)
+	api.GET("/cover-letters/:id", HandlerV1.GetCoverLetter)
+	api.DELETE("/cover-letters/:id", HandlerV1.DeleteCoverLetter)
+
+	// TEMPLATE
+	api.GET("/templates", HandlerV1.ListTemplates)
+	api.POST("/templates", HandlerV1.UploadTemplate)
+	api.GET("/templates/:name/preview", HandlerV1.GetTemplatePreview)
+	api.GET("/fonts/:file", HandlerV1.GetFont)
 
 	// STEP-RESUME
 	api.POST("/resume/basic", HandlerV1.BasicResumeData)
 	api.POST("/resume/main", HandlerV1.MainResumeData)
 	api.POST("/resume/generate", HandlerV1.LastGenerateResume)
+	api.POST("/resume/import/linkedin", HandlerV1.ImportLinkedIn)
 
 	url := ginSwagger.URL("swagger/doc.json")
 	api.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
@@ -1,43 +1,54 @@
 package services
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/docx"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/parser"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/pdf"
-	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/fs"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/text"
+	"time"
 )
 
 type ResumeService struct {
-	Parser *parser.HTMLParser
-	Pdf    *pdf.Generator
+	Parser   *parser.HTMLParser
+	Pdf      *pdf.Generator
+	Docx     *docx.Renderer
+	Text     *text.Renderer
+	Markdown *text.Renderer
 }
 
-func NewResumeService(parser *parser.HTMLParser, pdf *pdf.Generator) *ResumeService {
+func NewResumeService(parser *parser.HTMLParser, pdf *pdf.Generator, docx *docx.Renderer) *ResumeService {
 	return &ResumeService{
-		Parser: parser,
-		Pdf:    pdf,
+		Parser:   parser,
+		Pdf:      pdf,
+		Docx:     docx,
+		Text:     text.NewPlainRenderer(),
+		Markdown: text.NewMarkdownRenderer(),
 	}
 }
 
-func (s *ResumeService) GeneratePDF(resumeData models.Resume) error {
-	htmlFile, err := s.Parser.ParseToHtml(resumeData)
+// GeneratePDF renders the resume to HTML and prints it to PDF without touching the disk.
+func (s *ResumeService) GeneratePDF(ctx context.Context, resumeData models.Resume) ([]byte, error) {
+	now := time.Now()
+	pageOptions, err := pdf.NewPageOptions(resumeData.Meta.Page, resumeData.Basics.Name, now)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	pdfData, err := s.Pdf.GenerateFromHTML(htmlFile)
+	documentOptions, err := pdf.NewDocumentOptions(resumeData, now)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := fs.WriteFile(models.OutputPdfFile, pdfData); err != nil {
-		return err
+	html, err := s.Parser.ParseToHtml(resumeData)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return s.Pdf.GenerateFromHTML(ctx, html, pageOptions, documentOptions)
 }
 
 func (s *ResumeService) UnmarshalResume(data []byte) (models.Resume, error) {
@@ -350,6 +350,8 @@ type Resume struct {
 	Template             string   `protobuf:"bytes,11,opt,name=template,proto3" json:"template,omitempty"`
 	CreatedAt            string   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt            string   `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Content              string   `protobuf:"bytes,14,opt,name=content,proto3" json:"content,omitempty"`
+	Watermark            string   `protobuf:"bytes,15,opt,name=watermark,proto3" json:"watermark,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -472,6 +474,241 @@ func (m *Resume) GetUpdatedAt() string {
 	return ""
 }
 
+func (m *Resume) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *Resume) GetWatermark() string {
+	if m != nil {
+		return m.Watermark
+	}
+	return ""
+}
+
+type CoverLetterWithID struct {
+	CoverLetterId        string   `protobuf:"bytes,1,opt,name=cover_letter_id,json=coverLetterId,proto3" json:"cover_letter_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CoverLetterWithID) Reset()         { *m = CoverLetterWithID{} }
+func (m *CoverLetterWithID) String() string { return proto.CompactTextString(m) }
+func (*CoverLetterWithID) ProtoMessage()    {}
+func (*CoverLetterWithID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915681ec090d1576, []int{6}
+}
+func (m *CoverLetterWithID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoverLetterWithID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoverLetterWithID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoverLetterWithID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoverLetterWithID.Merge(m, src)
+}
+func (m *CoverLetterWithID) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoverLetterWithID) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoverLetterWithID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoverLetterWithID proto.InternalMessageInfo
+
+func (m *CoverLetterWithID) GetCoverLetterId() string {
+	if m != nil {
+		return m.CoverLetterId
+	}
+	return ""
+}
+
+type ListCoverLetterResponse struct {
+	CoverLetters         []*CoverLetter `protobuf:"bytes,1,rep,name=cover_letters,json=coverLetters,proto3" json:"cover_letters,omitempty"`
+	TotalCount           uint64         `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListCoverLetterResponse) Reset()         { *m = ListCoverLetterResponse{} }
+func (m *ListCoverLetterResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCoverLetterResponse) ProtoMessage()    {}
+func (*ListCoverLetterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915681ec090d1576, []int{7}
+}
+func (m *ListCoverLetterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCoverLetterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCoverLetterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCoverLetterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCoverLetterResponse.Merge(m, src)
+}
+func (m *ListCoverLetterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCoverLetterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCoverLetterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCoverLetterResponse proto.InternalMessageInfo
+
+func (m *ListCoverLetterResponse) GetCoverLetters() []*CoverLetter {
+	if m != nil {
+		return m.CoverLetters
+	}
+	return nil
+}
+
+func (m *ListCoverLetterResponse) GetTotalCount() uint64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+type CoverLetter struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Template             string   `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	Recipient            string   `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Company              string   `protobuf:"bytes,6,opt,name=company,proto3" json:"company,omitempty"`
+	Date                 string   `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`
+	Content              string   `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt            string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CoverLetter) Reset()         { *m = CoverLetter{} }
+func (m *CoverLetter) String() string { return proto.CompactTextString(m) }
+func (*CoverLetter) ProtoMessage()    {}
+func (*CoverLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915681ec090d1576, []int{8}
+}
+func (m *CoverLetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoverLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoverLetter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoverLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoverLetter.Merge(m, src)
+}
+func (m *CoverLetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoverLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoverLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoverLetter proto.InternalMessageInfo
+
+func (m *CoverLetter) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CoverLetter) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *CoverLetter) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CoverLetter) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *CoverLetter) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *CoverLetter) GetCompany() string {
+	if m != nil {
+		return m.Company
+	}
+	return ""
+}
+
+func (m *CoverLetter) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *CoverLetter) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *CoverLetter) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *CoverLetter) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ResumeWithID)(nil), "resume_service.ResumeWithID")
 	proto.RegisterType((*UserWithID)(nil), "resume_service.UserWithID")
@@ -479,42 +716,54 @@ func init() {
 	proto.RegisterType((*ListRequest)(nil), "resume_service.ListRequest")
 	proto.RegisterType((*ListResumeResponse)(nil), "resume_service.ListResumeResponse")
 	proto.RegisterType((*Resume)(nil), "resume_service.Resume")
+	proto.RegisterType((*CoverLetterWithID)(nil), "resume_service.CoverLetterWithID")
+	proto.RegisterType((*ListCoverLetterResponse)(nil), "resume_service.ListCoverLetterResponse")
+	proto.RegisterType((*CoverLetter)(nil), "resume_service.CoverLetter")
 }
 
 func init() { proto.RegisterFile("resume_model.proto", fileDescriptor_915681ec090d1576) }
 
 var fileDescriptor_915681ec090d1576 = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xdd, 0x8a, 0xd3, 0x40,
-	0x14, 0x36, 0xfd, 0x49, 0x93, 0x93, 0xba, 0xc8, 0x20, 0xbb, 0xd1, 0xc5, 0x58, 0x73, 0x55, 0x11,
-	0xaa, 0xe8, 0x13, 0xac, 0x7a, 0x53, 0x58, 0x10, 0xa2, 0x22, 0x78, 0x53, 0xa6, 0xc9, 0xa1, 0x4e,
-	0x49, 0x33, 0x71, 0xe6, 0x44, 0xec, 0x9b, 0xf8, 0x48, 0x7b, 0xe9, 0x23, 0x48, 0x7d, 0x11, 0x99,
-	0xc9, 0x44, 0x9a, 0x82, 0xc2, 0xde, 0xe5, 0xfb, 0xbe, 0xf3, 0x33, 0xe7, 0xfb, 0x08, 0x30, 0x85,
-	0xba, 0xd9, 0xe1, 0x6a, 0x27, 0x0b, 0x2c, 0x17, 0xb5, 0x92, 0x24, 0xd9, 0x99, 0xe3, 0x34, 0xaa,
-	0x6f, 0x22, 0xc7, 0xf4, 0x19, 0x4c, 0x33, 0xcb, 0x7c, 0x12, 0xf4, 0x65, 0xf9, 0x96, 0x5d, 0x42,
-	0xe8, 0x2a, 0x44, 0x11, 0x7b, 0x33, 0x6f, 0x1e, 0x66, 0x41, 0x4b, 0x2c, 0x8b, 0xf4, 0x1d, 0xc0,
-	0x47, 0x8d, 0xca, 0x95, 0x32, 0x18, 0xd5, 0x7c, 0x83, 0xb6, 0x6a, 0x94, 0xd9, 0x6f, 0x76, 0x1f,
-	0xc6, 0xa5, 0xd8, 0x09, 0x8a, 0x07, 0x96, 0x6c, 0x01, 0xbb, 0x80, 0x49, 0xa3, 0x51, 0x99, 0x91,
-	0x43, 0x3b, 0xd2, 0x37, 0x70, 0x59, 0xa4, 0x33, 0xf0, 0xdf, 0x13, 0xa7, 0x46, 0xb3, 0x73, 0xf0,
-	0x79, 0x4e, 0x42, 0x56, 0x76, 0x5c, 0x90, 0x39, 0x94, 0x1e, 0x3c, 0x88, 0xae, 0x85, 0xa6, 0x0c,
-	0xbf, 0x36, 0xa8, 0xe9, 0x16, 0x4b, 0x2f, 0x21, 0xdc, 0xca, 0xf5, 0x8a, 0x04, 0x95, 0xe8, 0xd6,
-	0x06, 0x5b, 0xb9, 0xfe, 0x60, 0x30, 0x7b, 0x02, 0x53, 0x23, 0x96, 0x32, 0xe7, 0x76, 0xe9, 0xc8,
-	0xea, 0xd1, 0x56, 0xae, 0xaf, 0x1d, 0xc5, 0x1e, 0x40, 0x60, 0xfb, 0xf7, 0x35, 0xc6, 0x63, 0x2b,
-	0x4f, 0x4c, 0xfb, 0xbe, 0x46, 0xf3, 0x58, 0xcd, 0x4b, 0xae, 0xf6, 0xb1, 0x3f, 0xf3, 0xe6, 0xc3,
-	0xcc, 0x21, 0xc3, 0x2b, 0xdc, 0x98, 0x79, 0x93, 0xf6, 0xcc, 0x16, 0xb1, 0x04, 0x00, 0xbf, 0xd7,
-	0xa8, 0x04, 0x56, 0x39, 0xc6, 0x81, 0xed, 0x39, 0x62, 0xd2, 0x0d, 0xb0, 0xf6, 0x46, 0xe3, 0x73,
-	0x86, 0xba, 0x96, 0x95, 0x46, 0xf6, 0x02, 0x26, 0xad, 0xf3, 0x3a, 0xf6, 0x66, 0xc3, 0x79, 0xf4,
-	0xf2, 0x7c, 0xd1, 0x0f, 0x6f, 0xe1, 0x1a, 0xba, 0x32, 0xf6, 0x18, 0x22, 0x92, 0xc4, 0xcb, 0x55,
-	0x2e, 0x9b, 0xaa, 0xb3, 0x03, 0x2c, 0xf5, 0xc6, 0x30, 0xe9, 0xcd, 0x00, 0xfc, 0xb6, 0x89, 0x9d,
-	0xc1, 0xe0, 0x6f, 0xc2, 0x03, 0x51, 0x1c, 0x67, 0x34, 0x38, 0xce, 0x88, 0xdd, 0x83, 0x61, 0xa3,
-	0x4a, 0xe7, 0xa0, 0xf9, 0x3c, 0x3a, 0x7f, 0x6c, 0x37, 0x74, 0xe7, 0xf7, 0x1c, 0xf7, 0x4f, 0x1c,
-	0xff, 0x97, 0x37, 0xa7, 0x49, 0x04, 0xff, 0x4f, 0x22, 0xec, 0x27, 0xd1, 0x77, 0x16, 0x4e, 0x9d,
-	0x65, 0x0f, 0x21, 0x20, 0xdc, 0xd5, 0x25, 0x27, 0x8c, 0xa3, 0xf6, 0x45, 0x1d, 0x66, 0x8f, 0x00,
-	0x72, 0x85, 0x9c, 0xb0, 0x58, 0x71, 0x8a, 0xa7, 0x56, 0x0d, 0x1d, 0x73, 0x45, 0x46, 0x6e, 0xea,
-	0xa2, 0x93, 0xef, 0xb6, 0xb2, 0x63, 0xae, 0xe8, 0xf5, 0xd3, 0x9b, 0x43, 0xe2, 0xfd, 0x3c, 0x24,
-	0xde, 0xaf, 0x43, 0xe2, 0xfd, 0xf8, 0x9d, 0xdc, 0xf9, 0x7c, 0xb1, 0xc1, 0xca, 0xfe, 0x64, 0xcf,
-	0xfb, 0x31, 0xad, 0x7d, 0xcb, 0xbe, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x13, 0x35, 0xac,
-	0x90, 0x03, 0x00, 0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xc5, 0x49, 0xea, 0xd8, 0x37, 0x69, 0x0b, 0x23, 0xd4, 0x1a, 0x5a, 0x42, 0xf0, 0x02, 0x15,
+	0x21, 0x15, 0x04, 0x4b, 0x36, 0x94, 0xb2, 0x89, 0x54, 0x09, 0xc9, 0x80, 0x90, 0xd8, 0x44, 0x13,
+	0xfb, 0x2a, 0x4c, 0x71, 0x3c, 0x66, 0x3c, 0x2e, 0x44, 0x62, 0xc1, 0x92, 0x4f, 0xe0, 0x93, 0x58,
+	0xf2, 0x09, 0x28, 0xfc, 0x08, 0x9a, 0x87, 0x13, 0xdb, 0x12, 0x15, 0x88, 0xdd, 0x9c, 0x73, 0x1f,
+	0x33, 0x73, 0xce, 0x9d, 0x01, 0x22, 0xb0, 0x28, 0x17, 0x38, 0x5d, 0xf0, 0x04, 0xd3, 0xe3, 0x5c,
+	0x70, 0xc9, 0xc9, 0x8e, 0xe5, 0x0a, 0x14, 0x17, 0x2c, 0xc6, 0xf0, 0x3e, 0x0c, 0x23, 0xcd, 0xbc,
+	0x61, 0xf2, 0xdd, 0xe4, 0x39, 0x39, 0x00, 0xdf, 0x66, 0xb0, 0x24, 0x70, 0xc6, 0xce, 0x91, 0x1f,
+	0x79, 0x86, 0x98, 0x24, 0xe1, 0x0b, 0x80, 0xd7, 0x05, 0x0a, 0x9b, 0x4a, 0xa0, 0x97, 0xd3, 0x39,
+	0xea, 0xac, 0x5e, 0xa4, 0xd7, 0xe4, 0x3a, 0x6c, 0xa5, 0x6c, 0xc1, 0x64, 0xd0, 0xd1, 0xa4, 0x01,
+	0x64, 0x1f, 0xfa, 0x65, 0x81, 0x42, 0xb5, 0xec, 0xea, 0x96, 0xae, 0x82, 0x93, 0x24, 0x1c, 0x83,
+	0xfb, 0x52, 0x52, 0x59, 0x16, 0x64, 0x0f, 0x5c, 0x1a, 0x4b, 0xc6, 0x33, 0xdd, 0xce, 0x8b, 0x2c,
+	0x0a, 0x57, 0x0e, 0x0c, 0xce, 0x58, 0x21, 0x23, 0xfc, 0x50, 0x62, 0x21, 0xff, 0x61, 0xd3, 0x03,
+	0xf0, 0xcf, 0xf9, 0x6c, 0x2a, 0x99, 0x4c, 0xd1, 0x6e, 0xeb, 0x9d, 0xf3, 0xd9, 0x2b, 0x85, 0xc9,
+	0x1d, 0x18, 0xaa, 0x60, 0xca, 0x63, 0xaa, 0x37, 0xed, 0xe9, 0xf8, 0xe0, 0x9c, 0xcf, 0xce, 0x2c,
+	0x45, 0x6e, 0x80, 0xa7, 0xeb, 0x97, 0x39, 0x06, 0x5b, 0x3a, 0xdc, 0x57, 0xe5, 0xcb, 0x1c, 0xd5,
+	0x61, 0x0b, 0x9a, 0x52, 0xb1, 0x0c, 0xdc, 0xb1, 0x73, 0xd4, 0x8d, 0x2c, 0x52, 0xbc, 0xc0, 0xb9,
+	0xea, 0xd7, 0x37, 0xd7, 0x34, 0x88, 0x8c, 0x00, 0xf0, 0x53, 0x8e, 0x82, 0x61, 0x16, 0x63, 0xe0,
+	0xe9, 0x9a, 0x1a, 0x13, 0xce, 0x81, 0x98, 0x3b, 0x2a, 0x9d, 0x23, 0x2c, 0x72, 0x9e, 0x15, 0x48,
+	0x1e, 0x42, 0xdf, 0x28, 0x5f, 0x04, 0xce, 0xb8, 0x7b, 0x34, 0x78, 0xb4, 0x77, 0xdc, 0x34, 0xef,
+	0xd8, 0x16, 0x54, 0x69, 0xe4, 0x36, 0x0c, 0x24, 0x97, 0x34, 0x9d, 0xc6, 0xbc, 0xcc, 0x2a, 0x39,
+	0x40, 0x53, 0xa7, 0x8a, 0x09, 0xbf, 0x74, 0xc1, 0x35, 0x45, 0x64, 0x07, 0x3a, 0x6b, 0x87, 0x3b,
+	0x2c, 0xa9, 0x7b, 0xd4, 0xa9, 0x7b, 0x44, 0xae, 0x42, 0xb7, 0x14, 0xa9, 0x55, 0x50, 0x2d, 0x6b,
+	0xd7, 0xdf, 0xd2, 0x3b, 0x54, 0xd7, 0x6f, 0x28, 0xee, 0xb6, 0x14, 0xff, 0x93, 0x36, 0x6d, 0x27,
+	0xbc, 0xcb, 0x9d, 0xf0, 0x9b, 0x4e, 0x34, 0x95, 0x85, 0xb6, 0xb2, 0xe4, 0x26, 0x78, 0x12, 0x17,
+	0x79, 0x4a, 0x25, 0x06, 0x03, 0x73, 0xa2, 0x0a, 0x93, 0x5b, 0x00, 0xb1, 0x40, 0x2a, 0x31, 0x99,
+	0x52, 0x19, 0x0c, 0x75, 0xd4, 0xb7, 0xcc, 0x89, 0x54, 0xe1, 0x32, 0x4f, 0xaa, 0xf0, 0xb6, 0x09,
+	0x5b, 0xe6, 0x44, 0x92, 0x00, 0xfa, 0x31, 0xcf, 0x24, 0x66, 0x32, 0xd8, 0x31, 0x67, 0xb2, 0x90,
+	0x1c, 0x82, 0xff, 0x91, 0x4a, 0x14, 0x0b, 0x2a, 0xde, 0x07, 0xbb, 0xa6, 0x6e, 0x4d, 0x84, 0x4f,
+	0xe0, 0xda, 0x29, 0xbf, 0x40, 0x71, 0x86, 0x52, 0xae, 0x9f, 0xd2, 0x5d, 0xd8, 0x8d, 0x15, 0x39,
+	0x4d, 0x35, 0xbb, 0x79, 0x7b, 0xdb, 0xf1, 0x26, 0x77, 0x92, 0x84, 0x9f, 0x61, 0x5f, 0x0d, 0x4a,
+	0xad, 0xc1, 0x7a, 0x5a, 0x9e, 0xc2, 0x76, 0xbd, 0x45, 0x35, 0x33, 0x07, 0xed, 0x99, 0xa9, 0xd7,
+	0x0e, 0x6b, 0xdd, 0xff, 0x62, 0x7a, 0xbe, 0x76, 0x60, 0x50, 0x2b, 0xff, 0x9f, 0x11, 0xaa, 0xfb,
+	0xd2, 0x6b, 0xf9, 0x72, 0x08, 0xbe, 0xc0, 0x98, 0xe5, 0x4c, 0x69, 0x6b, 0x5e, 0xde, 0x86, 0x30,
+	0xba, 0x2f, 0x72, 0x9a, 0x2d, 0xed, 0x88, 0x55, 0x50, 0x7d, 0x0d, 0xca, 0x1c, 0x3b, 0x5f, 0x7a,
+	0x5d, 0x77, 0xc9, 0x6b, 0xba, 0xd4, 0x74, 0xdf, 0xbf, 0xdc, 0x7d, 0x68, 0xb9, 0xff, 0xec, 0xde,
+	0xf7, 0xd5, 0xc8, 0xf9, 0xb1, 0x1a, 0x39, 0x3f, 0x57, 0x23, 0xe7, 0xdb, 0xaf, 0xd1, 0x95, 0xb7,
+	0xfb, 0x73, 0xcc, 0xf4, 0x17, 0xfb, 0xa0, 0x29, 0xf8, 0xcc, 0xd5, 0xec, 0xe3, 0xdf, 0x03, 0x00,
+	0x55, 0x28, 0xe7, 0xaf, 0x8e, 0x05, 0x00, 0x00,
 }
 
 func (m *ResumeWithID) Marshal() (dAtA []byte, err error) {
@@ -777,6 +1026,20 @@ func (m *Resume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Watermark) > 0 {
+		i -= len(m.Watermark)
+		copy(dAtA[i:], m.Watermark)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.Watermark)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.UpdatedAt) > 0 {
 		i -= len(m.UpdatedAt)
 		copy(dAtA[i:], m.UpdatedAt)
@@ -860,85 +1123,262 @@ func (m *Resume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintResumeModel(dAtA []byte, offset int, v uint64) int {
-	offset -= sovResumeModel(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CoverLetterWithID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ResumeWithID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ResumeId)
-	if l > 0 {
-		n += 1 + l + sovResumeModel(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *CoverLetterWithID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserWithID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CoverLetterWithID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Page != 0 {
-		n += 1 + sovResumeModel(uint64(m.Page))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovResumeModel(uint64(m.Limit))
-	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovResumeModel(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.CoverLetterId) > 0 {
+		i -= len(m.CoverLetterId)
+		copy(dAtA[i:], m.CoverLetterId)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.CoverLetterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Status) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Action {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ListCoverLetterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListCoverLetterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCoverLetterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Page != 0 {
-		n += 1 + sovResumeModel(uint64(m.Page))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovResumeModel(uint64(m.Limit))
+	if m.TotalCount != 0 {
+		i = encodeVarintResumeModel(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.JobTitle)
-	if l > 0 {
-		n += 1 + l + sovResumeModel(uint64(l))
+	if len(m.CoverLetters) > 0 {
+		for iNdEx := len(m.CoverLetters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoverLetters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResumeModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoverLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoverLetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoverLetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Company) > 0 {
+		i -= len(m.Company)
+		copy(dAtA[i:], m.Company)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.Company)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResumeModel(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintResumeModel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovResumeModel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ResumeWithID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ResumeId)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserWithID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovResumeModel(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovResumeModel(uint64(m.Limit))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovResumeModel(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovResumeModel(uint64(m.Limit))
+	}
+	l = len(m.JobTitle)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
 	}
 	l = len(m.JobLocation)
 	if l > 0 {
@@ -1037,6 +1477,103 @@ func (m *Resume) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovResumeModel(uint64(l))
 	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	l = len(m.Watermark)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CoverLetterWithID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CoverLetterId)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCoverLetterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CoverLetters) > 0 {
+		for _, e := range m.CoverLetters {
+			l = e.Size()
+			n += 1 + l + sovResumeModel(uint64(l))
+		}
+	}
+	if m.TotalCount != 0 {
+		n += 1 + sovResumeModel(uint64(m.TotalCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CoverLetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	l = len(m.Company)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovResumeModel(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1314,50 +1851,620 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResumeModel
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResumeModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTitle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobTitle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+			}
+			m.Salary = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Salary |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Experience", wireType)
+			}
+			m.Experience = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Experience |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResumeModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListResumeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResumeModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListResumeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListResumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resumes = append(m.Resumes, &Resume{})
+			if err := m.Resumes[len(m.Resumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			m.TotalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResumeModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Resume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResumeModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Resume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Resume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+			}
+			m.Salary = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Salary |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTitle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobTitle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResumeModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			m.JobLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobType", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResumeModel
@@ -1367,16 +2474,29 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Experience", wireType)
 			}
-			m.Limit = 0
+			m.Experience = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResumeModel
@@ -1386,14 +2506,14 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				m.Experience |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTitle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1421,11 +2541,11 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobTitle = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1453,11 +2573,11 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobLocation = string(dAtA[iNdEx:postIndex])
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1485,13 +2605,13 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobType = string(dAtA[iNdEx:postIndex])
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
-			m.Salary = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResumeModel
@@ -1501,14 +2621,27 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Salary |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Watermark", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1536,13 +2669,64 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Watermark = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Experience", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResumeModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Experience = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoverLetterWithID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResumeModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoverLetterWithID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoverLetterWithID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverLetterId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResumeModel
@@ -1552,11 +2736,24 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Experience |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResumeModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoverLetterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResumeModel(dAtA[iNdEx:])
@@ -1579,7 +2776,7 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListResumeResponse) Unmarshal(dAtA []byte) error {
+func (m *ListCoverLetterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1602,15 +2799,15 @@ func (m *ListResumeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListResumeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCoverLetterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListResumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCoverLetterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverLetters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1637,8 +2834,8 @@ func (m *ListResumeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resumes = append(m.Resumes, &Resume{})
-			if err := m.Resumes[len(m.Resumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CoverLetters = append(m.CoverLetters, &CoverLetter{})
+			if err := m.CoverLetters[len(m.CoverLetters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1683,7 +2880,7 @@ func (m *ListResumeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Resume) Unmarshal(dAtA []byte) error {
+func (m *CoverLetter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1706,10 +2903,10 @@ func (m *Resume) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Resume: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoverLetter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Resume: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoverLetter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1808,28 +3005,9 @@ func (m *Resume) Unmarshal(dAtA []byte) error {
 			}
 			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
-			}
-			m.Salary = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResumeModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Salary |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTitle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,11 +3035,11 @@ func (m *Resume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobTitle = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1889,11 +3067,11 @@ func (m *Resume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Company", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1921,11 +3099,11 @@ func (m *Resume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobLocation = string(dAtA[iNdEx:postIndex])
+			m.Company = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1953,30 +3131,11 @@ func (m *Resume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobType = string(dAtA[iNdEx:postIndex])
+			m.Date = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Experience", wireType)
-			}
-			m.Experience = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResumeModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Experience |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2004,9 +3163,9 @@ func (m *Resume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Content = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
@@ -2038,7 +3197,7 @@ func (m *Resume) Unmarshal(dAtA []byte) error {
 			}
 			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
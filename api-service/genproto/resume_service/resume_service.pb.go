@@ -27,23 +27,27 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("resume_service.proto", fileDescriptor_b6f3d3ddd1d37e28) }
 
 var fileDescriptor_b6f3d3ddd1d37e28 = []byte{
-	// 242 bytes of a gzipped FileDescriptorProto
+	// 314 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x29, 0x4a, 0x2d, 0x2e,
 	0xcd, 0x4d, 0x8d, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
 	0x17, 0xe2, 0x43, 0x15, 0x95, 0x12, 0x82, 0xf2, 0x73, 0xf3, 0x53, 0x52, 0x73, 0x20, 0x6a, 0x8c,
-	0xba, 0x58, 0xb8, 0x78, 0x83, 0xc0, 0xc2, 0xc1, 0x10, 0x55, 0x42, 0x2e, 0x5c, 0x3c, 0xce, 0x45,
-	0xa9, 0x89, 0x25, 0xa9, 0x10, 0x61, 0x21, 0x31, 0x3d, 0x34, 0xc3, 0x21, 0xe2, 0x52, 0x32, 0xd8,
-	0xc5, 0xc3, 0x33, 0x4b, 0x32, 0x3c, 0x5d, 0x84, 0xec, 0xb8, 0x78, 0x42, 0x0b, 0x52, 0x08, 0x9b,
-	0x82, 0x43, 0x1c, 0xe4, 0x0a, 0x97, 0xd4, 0x9c, 0x54, 0xb8, 0x7e, 0xbc, 0xb6, 0x61, 0x9a, 0x12,
-	0x5c, 0x92, 0x58, 0x52, 0x5a, 0x2c, 0xe4, 0xc6, 0x25, 0x00, 0x31, 0x25, 0xb4, 0x38, 0xb5, 0x08,
-	0x6a, 0x92, 0x14, 0xba, 0x5a, 0x90, 0x1c, 0x01, 0x73, 0x5c, 0xb9, 0x78, 0xdd, 0x53, 0x4b, 0x20,
-	0x06, 0x38, 0x55, 0x7a, 0xba, 0x90, 0xea, 0x1c, 0xa8, 0xd5, 0xfe, 0x60, 0x63, 0x88, 0x74, 0x8b,
-	0x12, 0xba, 0x9c, 0x4f, 0x66, 0x31, 0xd4, 0x09, 0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9,
-	0x42, 0xbe, 0x5c, 0x5c, 0x08, 0x51, 0x21, 0x69, 0xec, 0x3a, 0x0a, 0x4b, 0x53, 0x8b, 0x4b, 0x88,
-	0x31, 0xce, 0x49, 0xf3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3c, 0x3d, 0x35, 0x0f, 0x9c, 0x50, 0xf4, 0x51, 0x75, 0x27,
-	0xb1, 0x81, 0x45, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x00, 0xdd, 0x5b, 0xc1, 0x7a, 0x02,
-	0x00, 0x00,
+	0x1e, 0xb2, 0x71, 0xf1, 0x06, 0x81, 0x85, 0x83, 0x21, 0xaa, 0x84, 0x5c, 0xb8, 0x78, 0x9c, 0x8b,
+	0x52, 0x13, 0x4b, 0x52, 0x21, 0xc2, 0x42, 0x62, 0x7a, 0x68, 0x86, 0x43, 0xc4, 0xa5, 0x64, 0xb0,
+	0x8b, 0x87, 0x67, 0x96, 0x64, 0x78, 0xba, 0x08, 0xd9, 0x71, 0xf1, 0x84, 0x16, 0xa4, 0x10, 0x36,
+	0x05, 0x87, 0x38, 0xc8, 0x15, 0x2e, 0xa9, 0x39, 0xa9, 0x70, 0xfd, 0x78, 0x6d, 0xc3, 0x34, 0x25,
+	0xb8, 0x24, 0xb1, 0xa4, 0xb4, 0x58, 0xc8, 0x8d, 0x4b, 0x00, 0x62, 0x4a, 0x68, 0x71, 0x6a, 0x11,
+	0xd4, 0x24, 0x29, 0x74, 0xb5, 0x20, 0x39, 0x02, 0xe6, 0xb8, 0x72, 0xf1, 0xba, 0xa7, 0x96, 0x40,
+	0x0c, 0x70, 0xaa, 0xf4, 0x74, 0x21, 0xd5, 0x39, 0x50, 0xab, 0xfd, 0xc1, 0xc6, 0x10, 0xe9, 0x16,
+	0x25, 0x74, 0x39, 0x9f, 0xcc, 0x62, 0xa8, 0x13, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53,
+	0x85, 0x7c, 0xb9, 0xb8, 0x10, 0xa2, 0x42, 0xd2, 0xd8, 0x75, 0x14, 0x96, 0xa6, 0x16, 0x97, 0x10,
+	0x65, 0x5c, 0x30, 0x97, 0x20, 0x24, 0xea, 0x9d, 0xf3, 0xcb, 0x52, 0x8b, 0x7c, 0x52, 0x4b, 0x4a,
+	0x52, 0x8b, 0x30, 0x4d, 0x45, 0x92, 0x94, 0x52, 0xc4, 0x23, 0x09, 0x4d, 0x09, 0x7e, 0x5c, 0x82,
+	0x90, 0x38, 0x40, 0x36, 0x94, 0xb0, 0x3e, 0x9c, 0x71, 0x11, 0xc2, 0x25, 0xe4, 0x9e, 0x5a, 0x82,
+	0xa4, 0x1e, 0x1c, 0x21, 0x44, 0x18, 0x88, 0xcf, 0x23, 0x42, 0x51, 0x5c, 0xc2, 0xd0, 0xa8, 0x41,
+	0x12, 0x2d, 0xc6, 0x1b, 0x41, 0xea, 0xd8, 0x42, 0x14, 0x49, 0x37, 0x2c, 0x58, 0x9d, 0x34, 0x4f,
+	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x19, 0x8f, 0xe5, 0x18,
+	0xa2, 0xc4, 0xd3, 0x53, 0xf3, 0xc0, 0xf9, 0x4f, 0x1f, 0xd5, 0x88, 0x24, 0x36, 0xb0, 0xa8, 0x31,
+	0x60, 0x00, 0x9f, 0x5e, 0x57, 0x7c, 0xd1, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -65,6 +69,10 @@ type ResumeServiceClient interface {
 	GetResumeByID(ctx context.Context, in *ResumeWithID, opts ...grpc.CallOption) (*Resume, error)
 	GetUserResume(ctx context.Context, in *UserWithID, opts ...grpc.CallOption) (*ListResumeResponse, error)
 	ListResume(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResumeResponse, error)
+	CreateCoverLetter(ctx context.Context, in *CoverLetter, opts ...grpc.CallOption) (*CoverLetterWithID, error)
+	DeleteCoverLetter(ctx context.Context, in *CoverLetterWithID, opts ...grpc.CallOption) (*Status, error)
+	GetCoverLetterByID(ctx context.Context, in *CoverLetterWithID, opts ...grpc.CallOption) (*CoverLetter, error)
+	GetUserCoverLetters(ctx context.Context, in *UserWithID, opts ...grpc.CallOption) (*ListCoverLetterResponse, error)
 }
 
 type resumeServiceClient struct {
@@ -138,6 +146,42 @@ func (c *resumeServiceClient) ListResume(ctx context.Context, in *ListRequest, o
 	return out, nil
 }
 
+func (c *resumeServiceClient) CreateCoverLetter(ctx context.Context, in *CoverLetter, opts ...grpc.CallOption) (*CoverLetterWithID, error) {
+	out := new(CoverLetterWithID)
+	err := c.cc.Invoke(ctx, "/resume_service.ResumeService/CreateCoverLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) DeleteCoverLetter(ctx context.Context, in *CoverLetterWithID, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/resume_service.ResumeService/DeleteCoverLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) GetCoverLetterByID(ctx context.Context, in *CoverLetterWithID, opts ...grpc.CallOption) (*CoverLetter, error) {
+	out := new(CoverLetter)
+	err := c.cc.Invoke(ctx, "/resume_service.ResumeService/GetCoverLetterByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) GetUserCoverLetters(ctx context.Context, in *UserWithID, opts ...grpc.CallOption) (*ListCoverLetterResponse, error) {
+	out := new(ListCoverLetterResponse)
+	err := c.cc.Invoke(ctx, "/resume_service.ResumeService/GetUserCoverLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResumeServiceServer is the server API for ResumeService service.
 type ResumeServiceServer interface {
 	CreateResume(context.Context, *Resume) (*ResumeWithID, error)
@@ -147,6 +191,10 @@ type ResumeServiceServer interface {
 	GetResumeByID(context.Context, *ResumeWithID) (*Resume, error)
 	GetUserResume(context.Context, *UserWithID) (*ListResumeResponse, error)
 	ListResume(context.Context, *ListRequest) (*ListResumeResponse, error)
+	CreateCoverLetter(context.Context, *CoverLetter) (*CoverLetterWithID, error)
+	DeleteCoverLetter(context.Context, *CoverLetterWithID) (*Status, error)
+	GetCoverLetterByID(context.Context, *CoverLetterWithID) (*CoverLetter, error)
+	GetUserCoverLetters(context.Context, *UserWithID) (*ListCoverLetterResponse, error)
 }
 
 // UnimplementedResumeServiceServer can be embedded to have forward compatible implementations.
@@ -174,6 +222,18 @@ func (*UnimplementedResumeServiceServer) GetUserResume(ctx context.Context, req
 func (*UnimplementedResumeServiceServer) ListResume(ctx context.Context, req *ListRequest) (*ListResumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListResume not implemented")
 }
+func (*UnimplementedResumeServiceServer) CreateCoverLetter(ctx context.Context, req *CoverLetter) (*CoverLetterWithID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCoverLetter not implemented")
+}
+func (*UnimplementedResumeServiceServer) DeleteCoverLetter(ctx context.Context, req *CoverLetterWithID) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCoverLetter not implemented")
+}
+func (*UnimplementedResumeServiceServer) GetCoverLetterByID(ctx context.Context, req *CoverLetterWithID) (*CoverLetter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoverLetterByID not implemented")
+}
+func (*UnimplementedResumeServiceServer) GetUserCoverLetters(ctx context.Context, req *UserWithID) (*ListCoverLetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserCoverLetters not implemented")
+}
 
 func RegisterResumeServiceServer(s *grpc.Server, srv ResumeServiceServer) {
 	s.RegisterService(&_ResumeService_serviceDesc, srv)
@@ -305,6 +365,78 @@ func _ResumeService_ListResume_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResumeService_CreateCoverLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoverLetter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).CreateCoverLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resume_service.ResumeService/CreateCoverLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).CreateCoverLetter(ctx, req.(*CoverLetter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_DeleteCoverLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoverLetterWithID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).DeleteCoverLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resume_service.ResumeService/DeleteCoverLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).DeleteCoverLetter(ctx, req.(*CoverLetterWithID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_GetCoverLetterByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoverLetterWithID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).GetCoverLetterByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resume_service.ResumeService/GetCoverLetterByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).GetCoverLetterByID(ctx, req.(*CoverLetterWithID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_GetUserCoverLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserWithID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).GetUserCoverLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resume_service.ResumeService/GetUserCoverLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).GetUserCoverLetters(ctx, req.(*UserWithID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ResumeService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "resume_service.ResumeService",
 	HandlerType: (*ResumeServiceServer)(nil),
@@ -337,6 +469,22 @@ var _ResumeService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListResume",
 			Handler:    _ResumeService_ListResume_Handler,
 		},
+		{
+			MethodName: "CreateCoverLetter",
+			Handler:    _ResumeService_CreateCoverLetter_Handler,
+		},
+		{
+			MethodName: "DeleteCoverLetter",
+			Handler:    _ResumeService_DeleteCoverLetter_Handler,
+		},
+		{
+			MethodName: "GetCoverLetterByID",
+			Handler:    _ResumeService_GetCoverLetterByID_Handler,
+		},
+		{
+			MethodName: "GetUserCoverLetters",
+			Handler:    _ResumeService_GetUserCoverLetters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "resume_service.proto",
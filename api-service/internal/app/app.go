@@ -17,8 +17,11 @@ import (
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/infrastructure/rabbitmq"
 
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/config"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/fonts"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/logger"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/pdf"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/redis"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/usecase/app_version"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/usecase/event"
 )
@@ -33,6 +36,8 @@ type App struct {
 	BrokerProducer event.BrokerProducer
 	appVersion     app_version.AppVersion
 	writer         *rabbitmq.RabbitMQProducerImpl
+	pdfPool        *pdf.Pool
+	templates      *template.Manager
 }
 
 func NewApp(cfg config.Config) (*App, error) {
@@ -62,6 +67,26 @@ func NewApp(cfg config.Config) (*App, error) {
 		return nil, err
 	}
 
+	// headless chrome pool init
+	pdfPool, err := pdf.NewPool(pdf.PoolConfig{
+		Size:                cfg.Chrome.PoolSize,
+		HealthCheckInterval: cfg.Chrome.HealthCheckInterval,
+		Assets:              fonts.FS,
+		AssetOrigin:         fonts.Origin,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// resume templates init
+	templates, err := template.NewTemplateManager("ui")
+	if err != nil {
+		return nil, err
+	}
+	if err := templates.EnableCustomTemplates(cfg.Templates.CustomDir, "examples/example.resume.json"); err != nil {
+		return nil, err
+	}
+
 	// initialization enforcer
 	enforcer, err := casbin.NewEnforcer("auth.conf", "auth.csv")
 	if err != nil {
@@ -81,11 +106,13 @@ func NewApp(cfg config.Config) (*App, error) {
 	}
 
 	return &App{
-		Config:   &cfg,
-		Logger:   logger,
-		RedisDB:  redisdb,
-		Enforcer: enforcer,
-		writer:   writer,
+		Config:    &cfg,
+		Logger:    logger,
+		RedisDB:   redisdb,
+		Enforcer:  enforcer,
+		writer:    writer,
+		pdfPool:   pdfPool,
+		templates: templates,
 		// BrokerProducer: kafkaProducer,
 	}, nil
 }
@@ -112,6 +139,8 @@ func (a *App) Run() error {
 		BrokerProducer: a.BrokerProducer,
 		AppVersion:     a.appVersion,
 		Writer:         a.writer,
+		PdfPool:        a.pdfPool,
+		Templates:      a.templates,
 	})
 	err = a.Enforcer.LoadPolicy()
 	if err != nil {
@@ -141,6 +170,9 @@ func (a *App) Stop() {
 	//rabbitmq producer close
 	a.writer.Close()
 
+	// headless chrome pool close
+	a.pdfPool.Close()
+
 	// shutdown server http
 	if err := a.server.Shutdown(context.Background()); err != nil {
 		a.Logger.Error("shutdown server http ", zap.Error(err))
@@ -0,0 +1,236 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	styleTitle    = "Title"
+	styleSubtitle = "Subtitle"
+	styleHeading1 = "Heading1"
+	styleHeading2 = "Heading2"
+	styleMeta     = "Meta"
+	styleBullet   = "ListBullet"
+
+	// relationship ids below this value are taken by the static parts of the package
+	firstLinkRelationship = 10
+)
+
+// document accumulates WordprocessingML body content and the hyperlinks it references.
+type document struct {
+	body  bytes.Buffer
+	links []string
+}
+
+// escape returns s safe for XML text and attribute values.
+func escape(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// text returns a run, line breaks in s become soft breaks.
+func text(s string) string {
+	return formattedText(s, "")
+}
+
+func bold(s string) string {
+	return formattedText(s, "<w:b/>")
+}
+
+func italic(s string) string {
+	return formattedText(s, "<w:i/>")
+}
+
+func formattedText(s, props string) string {
+	if s == "" {
+		return ""
+	}
+
+	var run strings.Builder
+	run.WriteString("<w:r>")
+	if props != "" {
+		run.WriteString("<w:rPr>" + props + "</w:rPr>")
+	}
+	for i, line := range strings.Split(s, "\n") {
+		if i > 0 {
+			run.WriteString("<w:br/>")
+		}
+		run.WriteString(`<w:t xml:space="preserve">` + escape(line) + "</w:t>")
+	}
+	run.WriteString("</w:r>")
+
+	return run.String()
+}
+
+// hyperlink registers url as an external relationship and returns a linked run.
+func (d *document) hyperlink(url, label string) string {
+	if url == "" {
+		return text(label)
+	}
+	if label == "" {
+		label = url
+	}
+
+	d.links = append(d.links, url)
+	id := fmt.Sprintf("rId%d", firstLinkRelationship+len(d.links)-1)
+
+	return fmt.Sprintf(
+		`<w:hyperlink r:id="%s"><w:r><w:rPr><w:rStyle w:val="Hyperlink"/></w:rPr><w:t xml:space="preserve">%s</w:t></w:r></w:hyperlink>`,
+		id, escape(label),
+	)
+}
+
+// paragraph appends a paragraph with the given style, empty runs are skipped.
+func (d *document) paragraph(style string, runs ...string) {
+	content := strings.Join(runs, "")
+	if content == "" {
+		return
+	}
+
+	d.body.WriteString("<w:p>")
+	if style != "" {
+		d.body.WriteString(`<w:pPr><w:pStyle w:val="` + style + `"/></w:pPr>`)
+	}
+	d.body.WriteString(content)
+	d.body.WriteString("</w:p>")
+}
+
+func (d *document) heading(level int, runs ...string) {
+	if level == 1 {
+		d.paragraph(styleHeading1, runs...)
+		return
+	}
+	d.paragraph(styleHeading2, runs...)
+}
+
+func (d *document) bullet(runs ...string) {
+	d.paragraph(styleBullet, runs...)
+}
+
+// properties are written to docProps/core.xml.
+type properties struct {
+	Title    string
+	Subject  string
+	Creator  string
+	Keywords string
+}
+
+// pack zips the document into a .docx package.
+func (d *document) pack(props properties, now time.Time) ([]byte, error) {
+	var out bytes.Buffer
+	zw := zip.NewWriter(&out)
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", rootRelsXML},
+		{"docProps/core.xml", d.coreXML(props, now)},
+		{"word/document.xml", d.documentXML()},
+		{"word/styles.xml", stylesXML},
+		{"word/numbering.xml", numberingXML},
+		{"word/_rels/document.xml.rels", d.documentRelsXML()},
+	}
+
+	for _, part := range parts {
+		w, err := zw.Create(part.name)
+		if err != nil {
+			return nil, errors.Wrap(err, "docx pack - zip.Create "+part.name)
+		}
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return nil, errors.Wrap(err, "docx pack - write "+part.name)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, errors.Wrap(err, "docx pack - zip.Close")
+	}
+
+	return out.Bytes(), nil
+}
+
+func (d *document) documentXML() string {
+	return xml.Header +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		"<w:body>" + d.body.String() +
+		`<w:sectPr><w:pgSz w:w="11906" w:h="16838"/><w:pgMar w:top="1134" w:right="1134" w:bottom="1134" w:left="1134" w:header="708" w:footer="708" w:gutter="0"/></w:sectPr>` +
+		"</w:body></w:document>"
+}
+
+func (d *document) documentRelsXML() string {
+	var rels strings.Builder
+	rels.WriteString(xml.Header)
+	rels.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	rels.WriteString(`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`)
+	rels.WriteString(`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/numbering" Target="numbering.xml"/>`)
+	for i, link := range d.links {
+		rels.WriteString(fmt.Sprintf(
+			`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink" Target="%s" TargetMode="External"/>`,
+			firstLinkRelationship+i, escape(link),
+		))
+	}
+	rels.WriteString("</Relationships>")
+
+	return rels.String()
+}
+
+func (d *document) coreXML(props properties, now time.Time) string {
+	created := now.UTC().Format(time.RFC3339)
+
+	return xml.Header +
+		`<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:dcterms="http://purl.org/dc/terms/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+		"<dc:title>" + escape(props.Title) + "</dc:title>" +
+		"<dc:subject>" + escape(props.Subject) + "</dc:subject>" +
+		"<dc:creator>" + escape(props.Creator) + "</dc:creator>" +
+		"<cp:keywords>" + escape(props.Keywords) + "</cp:keywords>" +
+		`<dcterms:created xsi:type="dcterms:W3CDTF">` + created + "</dcterms:created>" +
+		`<dcterms:modified xsi:type="dcterms:W3CDTF">` + created + "</dcterms:modified>" +
+		"</cp:coreProperties>"
+}
+
+const contentTypesXML = xml.Header +
+	`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>` +
+	`<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>` +
+	`<Override PartName="/word/numbering.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml"/>` +
+	`<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>` +
+	`</Types>`
+
+const rootRelsXML = xml.Header +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" Target="docProps/core.xml"/>` +
+	`</Relationships>`
+
+const stylesXML = xml.Header +
+	`<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+	`<w:docDefaults><w:rPrDefault><w:rPr><w:rFonts w:ascii="Calibri" w:hAnsi="Calibri" w:cs="Calibri" w:eastAsia="Calibri"/><w:sz w:val="21"/><w:szCs w:val="21"/></w:rPr></w:rPrDefault>` +
+	`<w:pPrDefault><w:pPr><w:spacing w:after="80" w:line="264" w:lineRule="auto"/></w:pPr></w:pPrDefault></w:docDefaults>` +
+	`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:qFormat/></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Title"><w:name w:val="Title"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:spacing w:after="40"/></w:pPr><w:rPr><w:b/><w:caps/><w:sz w:val="48"/><w:szCs w:val="48"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Subtitle"><w:name w:val="Subtitle"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:spacing w:after="160"/></w:pPr><w:rPr><w:color w:val="404040"/><w:sz w:val="28"/><w:szCs w:val="28"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:pBdr><w:bottom w:val="single" w:sz="8" w:space="1" w:color="000000"/></w:pBdr><w:spacing w:before="280" w:after="120"/><w:outlineLvl w:val="0"/></w:pPr><w:rPr><w:b/><w:caps/><w:sz w:val="28"/><w:szCs w:val="28"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Heading2"><w:name w:val="heading 2"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:spacing w:before="160" w:after="20"/><w:outlineLvl w:val="1"/></w:pPr><w:rPr><w:b/><w:sz w:val="24"/><w:szCs w:val="24"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Meta"><w:name w:val="Meta"/><w:basedOn w:val="Normal"/><w:qFormat/><w:rPr><w:i/><w:color w:val="595959"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="ListBullet"><w:name w:val="List Bullet"/><w:basedOn w:val="Normal"/><w:qFormat/><w:pPr><w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr><w:spacing w:after="40"/><w:ind w:left="360" w:hanging="360"/></w:pPr></w:style>` +
+	`<w:style w:type="character" w:styleId="Hyperlink"><w:name w:val="Hyperlink"/><w:rPr><w:color w:val="0563C1"/><w:u w:val="single"/></w:rPr></w:style>` +
+	`</w:styles>`
+
+const numberingXML = xml.Header +
+	`<w:numbering xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+	`<w:abstractNum w:abstractNumId="0"><w:multiLevelType w:val="singleLevel"/>` +
+	`<w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:lvlText w:val="•"/><w:lvlJc w:val="left"/><w:pPr><w:ind w:left="360" w:hanging="360"/></w:pPr></w:lvl>` +
+	`</w:abstractNum>` +
+	`<w:num w:numId="1"><w:abstractNumId w:val="0"/></w:num>` +
+	`</w:numbering>`
@@ -0,0 +1,312 @@
+package docx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/export"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/logger"
+	"github.com/pkg/errors"
+)
+
+// Renderer turns a resume into a Word document without any external binary.
+type Renderer struct{}
+
+// NewRenderer creates a new instance of the DOCX Renderer.
+func NewRenderer() *Renderer {
+	return &Renderer{}
+}
+
+// Render returns the .docx file content of the resume.
+func (r *Renderer) Render(resumeData models.Resume) ([]byte, error) {
+	startedAt := time.Now()
+
+	d := &document{}
+
+	r.writeBasics(d, &resumeData)
+	r.writeWork(d, &resumeData)
+	r.writeProjects(d, &resumeData)
+	r.writeEducation(d, &resumeData)
+	r.writeCertificates(d, &resumeData)
+	r.writeSkills(d, resumeData.GetSkillsLabel(), resumeData.Skills)
+	r.writeSkills(d, resumeData.GetSoftSkillsLabel(), resumeData.SoftSkills)
+	r.writeLanguages(d, &resumeData)
+	r.writeInterests(d, &resumeData)
+	r.writeVolunteer(d, &resumeData)
+	r.writeAwards(d, &resumeData)
+	r.writePublications(d, &resumeData)
+	r.writeReferences(d, &resumeData)
+	r.writeCustomSections(d, &resumeData)
+
+	data, err := d.pack(properties{
+		Title:    resumeData.Basics.Name,
+		Subject:  resumeData.Basics.Label,
+		Creator:  resumeData.Basics.Name,
+		Keywords: export.SkillNames(resumeData.Skills),
+	}, startedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "Render - pack")
+	}
+
+	logger.Error(errors.New(fmt.Sprintf("DOCX of %d bytes generated in %f seconds", len(data), time.Since(startedAt).Seconds())))
+
+	return data, nil
+}
+
+func (r *Renderer) writeBasics(d *document, resumeData *models.Resume) {
+	basics := resumeData.Basics
+
+	d.paragraph(styleTitle, text(basics.Name))
+	d.paragraph(styleSubtitle, text(basics.Label))
+
+	var contacts []string
+	if basics.Email != "" {
+		contacts = append(contacts, d.hyperlink("mailto:"+basics.Email, basics.Email))
+	}
+	if basics.Phone != "" {
+		contacts = append(contacts, d.hyperlink("tel:"+basics.Phone, basics.Phone))
+	}
+	if location := export.Location(basics.Location); location != "" {
+		contacts = append(contacts, text(location))
+	}
+	if basics.URL != "" {
+		contacts = append(contacts, d.hyperlink(export.AbsoluteURL(basics.URL), basics.URL))
+	}
+	for _, profile := range basics.Profiles {
+		contacts = append(contacts, d.hyperlink(export.AbsoluteURL(profile.URL), profile.Network))
+	}
+	d.paragraph(styleMeta, strings.Join(contacts, text(" | ")))
+
+	if basics.Summary != "" {
+		d.heading(1, text(resumeData.GetProfileLabel()))
+		d.paragraph("", text(basics.Summary))
+	}
+}
+
+func (r *Renderer) writeWork(d *document, resumeData *models.Resume) {
+	if len(resumeData.Work) == 0 {
+		return
+	}
+
+	d.heading(1, text(resumeData.GetExperiencesLabel()))
+	since := resumeData.GetSinceLabel()
+
+	for _, work := range resumeData.Work {
+		title := work.Position
+		if work.ContractType != "" {
+			title += " (" + work.ContractType + ")"
+		}
+		if work.Company != "" {
+			title += " | " + work.Company
+		}
+		d.heading(2, text(title))
+		d.paragraph(styleMeta, text(export.JoinNonEmpty(" | ", work.Location, export.DateRange(work.StartDate, work.EndDate, since))))
+		d.paragraph("", text(work.Summary))
+		for _, skill := range work.Skills {
+			d.bullet(text(skill))
+		}
+	}
+}
+
+func (r *Renderer) writeProjects(d *document, resumeData *models.Resume) {
+	if len(resumeData.Projects) == 0 {
+		return
+	}
+
+	d.heading(1, text(resumeData.GetProjectsLabel()))
+
+	for _, project := range resumeData.Projects {
+		if project.URL != "" {
+			d.heading(2, d.hyperlink(export.AbsoluteURL(project.URL), project.Name))
+		} else {
+			d.heading(2, text(project.Name))
+		}
+		d.paragraph("", text(project.Description))
+	}
+}
+
+func (r *Renderer) writeEducation(d *document, resumeData *models.Resume) {
+	if len(resumeData.Education) == 0 {
+		return
+	}
+
+	d.heading(1, text(resumeData.GetEducationLabel()))
+
+	for _, education := range resumeData.Education {
+		d.heading(2, text(export.JoinNonEmpty(" | ", export.JoinNonEmpty(" ", education.StudyType, education.Area), education.Institution)))
+		d.paragraph(styleMeta, text(export.JoinNonEmpty(" | ", education.Location, export.JoinNonEmpty(" - ", education.StartDate, education.EndDate))))
+		d.paragraph("", text(education.Score))
+		for _, course := range education.Courses {
+			d.bullet(text(course))
+		}
+	}
+}
+
+func (r *Renderer) writeCertificates(d *document, resumeData *models.Resume) {
+	if len(resumeData.Certificates) == 0 {
+		return
+	}
+
+	d.heading(1, text(resumeData.GetCertificationsLabel()))
+
+	for _, certificate := range resumeData.Certificates {
+		title := text(certificate.Title)
+		if certificate.URL != "" {
+			title = d.hyperlink(export.AbsoluteURL(certificate.URL), certificate.Title)
+		}
+		details := export.JoinNonEmpty(", ", certificate.Issuer, certificate.Date, certificate.Score)
+		if details != "" {
+			details = " (" + details + ")"
+		}
+		d.bullet(title, text(details))
+	}
+}
+
+func (r *Renderer) writeSkills(d *document, label string, skills []models.Skill) {
+	if len(skills) == 0 {
+		return
+	}
+
+	d.heading(1, text(label))
+
+	for _, skill := range skills {
+		name := skill.Name
+		if skill.Level != "" {
+			name += " (" + skill.Level + ")"
+		}
+		keywords := strings.Join(skill.Keywords, ", ")
+		if keywords != "" {
+			keywords = ": " + keywords
+		}
+		d.bullet(bold(name), text(keywords))
+	}
+}
+
+func (r *Renderer) writeLanguages(d *document, resumeData *models.Resume) {
+	if len(resumeData.Languages) == 0 {
+		return
+	}
+
+	d.heading(1, text(resumeData.GetLanguagesLabel()))
+
+	for _, language := range resumeData.Languages {
+		fluency := ""
+		if language.Fluency != "" {
+			fluency = " (" + language.Fluency + ")"
+		}
+		d.bullet(text(language.Language), italic(fluency))
+	}
+}
+
+func (r *Renderer) writeInterests(d *document, resumeData *models.Resume) {
+	if len(resumeData.Interests) == 0 {
+		return
+	}
+
+	d.heading(1, text(resumeData.GetInterestsLabel()))
+
+	for _, interest := range resumeData.Interests {
+		keywords := strings.Join(interest.Keywords, ", ")
+		if keywords != "" {
+			keywords = ": " + keywords
+		}
+		d.bullet(text(interest.Name), text(keywords))
+	}
+}
+
+func (r *Renderer) writeVolunteer(d *document, resumeData *models.Resume) {
+	if len(resumeData.Volunteer) == 0 {
+		return
+	}
+
+	d.heading(1, text(resumeData.GetVolunteerLabel()))
+	since := resumeData.GetSinceLabel()
+
+	for _, volunteer := range resumeData.Volunteer {
+		title := export.JoinNonEmpty(" | ", volunteer.Position, volunteer.Organization)
+		if volunteer.URL != "" {
+			d.heading(2, d.hyperlink(export.AbsoluteURL(volunteer.URL), title))
+		} else {
+			d.heading(2, text(title))
+		}
+		d.paragraph(styleMeta, text(export.DateRange(volunteer.StartDate, volunteer.EndDate, since)))
+		d.paragraph("", text(volunteer.Summary))
+		for _, highlight := range volunteer.Highlights {
+			d.bullet(text(highlight))
+		}
+	}
+}
+
+func (r *Renderer) writeAwards(d *document, resumeData *models.Resume) {
+	if len(resumeData.Awards) == 0 {
+		return
+	}
+
+	d.heading(1, text(resumeData.GetAwardsLabel()))
+
+	for _, award := range resumeData.Awards {
+		d.heading(2, text(award.Title))
+		d.paragraph(styleMeta, text(export.JoinNonEmpty(" | ", award.Awarder, award.Date)))
+		d.paragraph("", text(award.Summary))
+	}
+}
+
+func (r *Renderer) writePublications(d *document, resumeData *models.Resume) {
+	if len(resumeData.Publications) == 0 {
+		return
+	}
+
+	d.heading(1, text(resumeData.GetPublicationsLabel()))
+
+	for _, publication := range resumeData.Publications {
+		if publication.URL != "" {
+			d.heading(2, d.hyperlink(export.AbsoluteURL(publication.URL), publication.Name))
+		} else {
+			d.heading(2, text(publication.Name))
+		}
+		d.paragraph(styleMeta, text(export.JoinNonEmpty(" | ", publication.Publisher, publication.ReleaseDate)))
+		d.paragraph("", text(publication.Summary))
+	}
+}
+
+func (r *Renderer) writeReferences(d *document, resumeData *models.Resume) {
+	if len(resumeData.References) == 0 {
+		return
+	}
+
+	d.heading(1, text(resumeData.GetReferencesLabel()))
+
+	for _, reference := range resumeData.References {
+		d.heading(2, text(reference.Name))
+		d.paragraph("", text(reference.Reference))
+	}
+}
+
+// writeCustomSections writes every custom section under the title given by the user.
+func (r *Renderer) writeCustomSections(d *document, resumeData *models.Resume) {
+	since := resumeData.GetSinceLabel()
+
+	for _, section := range resumeData.CustomSections {
+		if len(section.Entries) == 0 {
+			continue
+		}
+
+		d.heading(1, text(section.Title))
+
+		for _, entry := range section.Entries {
+			title := export.JoinNonEmpty(" | ", entry.Title, entry.Subtitle)
+			if entry.URL != "" {
+				d.heading(2, d.hyperlink(export.AbsoluteURL(entry.URL), title))
+			} else {
+				d.heading(2, text(title))
+			}
+			d.paragraph(styleMeta, text(export.JoinNonEmpty(" | ", entry.Location, export.DateRange(entry.StartDate, entry.EndDate, since))))
+			d.paragraph("", text(entry.Summary))
+			for _, highlight := range entry.Highlights {
+				d.bullet(text(highlight))
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package docx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/docx"
+)
+
+const exampleFile = "../../../examples/example.resume.json"
+
+// unpack returns the parts of a .docx package keyed by their name, every part must be
+// well-formed XML.
+func unpack(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := make(map[string]string, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoder := xml.NewDecoder(bytes.NewReader(content))
+		for {
+			if _, err := decoder.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%s is not well-formed: %v", f.Name, err)
+			}
+		}
+		parts[f.Name] = string(content)
+	}
+
+	return parts
+}
+
+func TestRender(t *testing.T) {
+	data, err := os.ReadFile(exampleFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resume models.Resume
+	if err := json.Unmarshal(data, &resume); err != nil {
+		t.Fatal(err)
+	}
+	// markup in the resume must end up as text, not break the document
+	resume.Basics.Label = `DevOps <Engineer> & "SRE"`
+
+	out, err := docx.NewRenderer().Render(resume)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := unpack(t, out)
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "docProps/core.xml", "word/document.xml", "word/styles.xml", "word/numbering.xml", "word/_rels/document.xml.rels"} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("the package misses %s", name)
+		}
+	}
+
+	body := parts["word/document.xml"]
+	for _, s := range []string{
+		"John Doe Rust", "DevOps &lt;Engineer&gt; &amp; &#34;SRE&#34;",
+		resume.GetExperiencesLabel(), "French Med Company",
+		resume.GetProjectsLabel(), "CloudFormation Templates",
+		resume.GetEducationLabel(), "Alta Nova University",
+		resume.GetCertificationsLabel(), "Docker Certified Associate (DCA)",
+		resume.GetSkillsLabel(), "Go/Java/TypeScript",
+		resume.GetLanguagesLabel(), "Fluent",
+		resume.GetInterestsLabel(), "Cooking",
+	} {
+		if !strings.Contains(body, s) {
+			t.Errorf("word/document.xml misses %q", s)
+		}
+	}
+
+	rels := parts["word/_rels/document.xml.rels"]
+	for _, link := range []string{"mailto:johndoerust@mail.com", "tel:07123400808", "https://jdoerust.com", "https://github.com/johndoerust"} {
+		if !strings.Contains(rels, `Target="`+link+`"`) {
+			t.Errorf("word/_rels/document.xml.rels misses the link to %s", link)
+		}
+	}
+
+	if !strings.Contains(parts["docProps/core.xml"], "<dc:title>John Doe Rust</dc:title>") {
+		t.Error("docProps/core.xml misses the title")
+	}
+}
+
+func TestRenderWritesEverySection(t *testing.T) {
+	data, err := docx.NewRenderer().Render(models.Resume{
+		Basics:       models.Basics{Name: "John Doe"},
+		Volunteer:    []models.Volunteer{{Organization: "Code Club", Position: "Mentor", Highlights: []string{"Taught Go to teenagers"}}},
+		Awards:       []models.Award{{Title: "Gopher of the Year", Awarder: "Go Community"}},
+		Publications: []models.Publication{{Name: "Concurrency in Practice", URL: "go.dev/blog"}},
+		References:   []models.Reference{{Name: "Jane Roe", Reference: "John shipped every release on time."}},
+		CustomSections: []models.CustomSection{
+			{Title: "Talks", Entries: []models.CustomEntry{{Title: "Scaling Go services", Highlights: []string{"500 attendees"}}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := unpack(t, data)
+
+	body := parts["word/document.xml"]
+	for _, s := range []string{
+		"Mentor | Code Club", "Taught Go to teenagers",
+		"Gopher of the Year", "Go Community",
+		"Concurrency in Practice",
+		"Jane Roe", "John shipped every release on time.",
+		"Talks", "Scaling Go services", "500 attendees",
+	} {
+		if !strings.Contains(body, s) {
+			t.Errorf("word/document.xml misses %q", s)
+		}
+	}
+	if !strings.Contains(parts["word/_rels/document.xml.rels"], `Target="https://go.dev/blog"`) {
+		t.Error("the publication is not linked")
+	}
+}
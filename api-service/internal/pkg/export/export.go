@@ -0,0 +1,52 @@
+package export
+
+import (
+	"strings"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+// DateRange returns the period of an entry, the since label introduces entries still going on.
+func DateRange(startDate, endDate, since string) string {
+	if endDate != "" {
+		return JoinNonEmpty(" - ", startDate, endDate)
+	}
+	if startDate != "" {
+		return since + " " + startDate
+	}
+	return ""
+}
+
+// Location returns the city, region and country of a location, without the empty ones.
+func Location(location models.Location) string {
+	return JoinNonEmpty(", ", location.City, location.Region, location.CountryCode)
+}
+
+// JoinNonEmpty joins the trimmed values with sep, blank values are left out.
+func JoinNonEmpty(sep string, values ...string) string {
+	var parts []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// AbsoluteURL adds a scheme to bare hosts such as "jdoerust.com" so readers open them as
+// web links, mail and phone links are kept.
+func AbsoluteURL(url string) string {
+	if url == "" || strings.Contains(url, "://") || strings.HasPrefix(url, "mailto:") || strings.HasPrefix(url, "tel:") {
+		return url
+	}
+	return "https://" + url
+}
+
+// SkillNames returns the names of the skills separated by commas.
+func SkillNames(skills []models.Skill) string {
+	names := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		names = append(names, skill.Name)
+	}
+	return strings.Join(names, ", ")
+}
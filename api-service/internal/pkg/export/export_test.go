@@ -0,0 +1,60 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+func TestDateRange(t *testing.T) {
+	tests := []struct {
+		startDate, endDate string
+		want               string
+	}{
+		{startDate: "2020-01", endDate: "2022-06", want: "2020-01 - 2022-06"},
+		{startDate: "2020-01", want: "Since 2020-01"},
+		{endDate: "2022-06", want: "2022-06"},
+		{startDate: " ", endDate: "2022-06", want: "2022-06"},
+		{},
+	}
+
+	for _, tt := range tests {
+		if got := DateRange(tt.startDate, tt.endDate, "Since"); got != tt.want {
+			t.Errorf("DateRange(%q, %q) = %q, want %q", tt.startDate, tt.endDate, got, tt.want)
+		}
+	}
+}
+
+func TestLocation(t *testing.T) {
+	if got := Location(models.Location{City: "Paris", Region: " ", CountryCode: "FR"}); got != "Paris, FR" {
+		t.Errorf("Location() = %q, want %q", got, "Paris, FR")
+	}
+	if got := Location(models.Location{}); got != "" {
+		t.Errorf("Location() = %q for an empty location", got)
+	}
+}
+
+func TestAbsoluteURL(t *testing.T) {
+	tests := map[string]string{
+		"":                         "",
+		"jdoerust.com":             "https://jdoerust.com",
+		"http://jdoerust.com":      "http://jdoerust.com",
+		"https://github.com/jdoe":  "https://github.com/jdoe",
+		"mailto:johndoe@mail.com":  "mailto:johndoe@mail.com",
+		"tel:07123400808":          "tel:07123400808",
+		"ftp://files.jdoerust.com": "ftp://files.jdoerust.com",
+	}
+
+	for url, want := range tests {
+		if got := AbsoluteURL(url); got != want {
+			t.Errorf("AbsoluteURL(%q) = %q, want %q", url, got, want)
+		}
+	}
+}
+
+func TestSkillNames(t *testing.T) {
+	skills := []models.Skill{{Name: "Go", Level: "Expert"}, {Name: "Kubernetes", Keywords: []string{"Helm"}}}
+	if got := SkillNames(skills); got != "Go, Kubernetes" {
+		t.Errorf("SkillNames() = %q, want %q", got, "Go, Kubernetes")
+	}
+}
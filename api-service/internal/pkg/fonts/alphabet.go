@@ -0,0 +1,38 @@
+package fonts
+
+import "golang.org/x/text/language"
+
+const (
+	latin    = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	cyrillic = "АБВГДЕЁЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯабвгдеёжзийклмнопрстуфхцчшщъыьэюя"
+	arabic   = "ءآأؤإئابةتثجحخدذرزسشصضطظعغفقكلمنهوىي،؛؟"
+)
+
+// alphabets hold the letters and signs a resume in a language is written with, keyed by
+// language and script so that Uzbek in Latin and in Cyrillic are told apart.
+var alphabets = map[string]string{
+	"en-Latn": latin,
+	"fr-Latn": latin + "ÀÂÆÇÉÈÊËÎÏÔŒÙÛÜŸàâæçéèêëîïôœùûüÿ«»",
+	"ru-Cyrl": cyrillic + "«»№",
+	// oʻ and gʻ are written with the turned comma, the tutuq belgisi with the apostrophe
+	"uz-Latn": latin + "ʻʼ",
+	"uz-Cyrl": "АБВГДЕЁЖЗИЙКЛМНОПРСТУФХЧШЪЭЮЯЎҚҒҲабвгдеёжзийклмнопрстуфхчшъэюяўқғҳ",
+	"ar-Arab": arabic + "٠١٢٣٤٥٦٧٨٩",
+	// Persian has its own kaf and yeh, its own digits and joins word parts with the zero width non-joiner
+	"fa-Arab": arabic + "پچژگکی۰۱۲۳۴۵۶۷۸۹\u200c",
+}
+
+// alphabet returns the alphabet of a meta.lang value, English when it is empty.
+func alphabet(lang string) string {
+	if lang == "" {
+		lang = "en"
+	}
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return ""
+	}
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+
+	return alphabets[base.String()+"-"+script.String()]
+}
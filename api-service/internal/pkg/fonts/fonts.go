@@ -0,0 +1,211 @@
+package fonts
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+// Origin is where documents load the bundled fonts from. It does not exist on the
+// network, the renderer answers its requests from FS.
+const Origin = "https://fonts.resume.internal/"
+
+// Fallback follows the chosen family in every font stack, it covers the Latin and
+// Cyrillic alphabets we know.
+const Fallback = "Fira Sans"
+
+// scriptFallbacks come after Fallback for the scripts it has no glyphs for.
+var scriptFallbacks = []string{"Amiri"}
+
+//go:embed files/*.woff2 files/*.ttf
+var files embed.FS
+
+// FS holds the font files served at Origin.
+var FS = mustSub(files, "files")
+
+type face struct {
+	file string
+	// format is the CSS font format of the file, woff2 when empty
+	format string
+	// weight is a CSS font-weight range, a face stands in for the weights we do not ship
+	weight string
+	italic bool
+}
+
+// Family is a font family embedded in the binary, all of them are open-licensed.
+type Family struct {
+	Name string
+	// Generic is the CSS generic family used when no bundled font has a glyph
+	Generic string
+
+	faces    []face
+	coverage map[rune]bool
+}
+
+var families = []*Family{
+	{Name: "Fira Sans", Generic: "sans-serif", faces: []face{
+		{file: "FiraSans-Regular.woff2", weight: "100 400"},
+		{file: "FiraSans-Italic.woff2", weight: "100 400", italic: true},
+		// the medium face is the heaviest one we ship, it is used for bold text
+		{file: "FiraSans-Medium.woff2", weight: "500 900"},
+		{file: "FiraSans-MediumItalic.woff2", weight: "500 900", italic: true},
+	}},
+	{Name: "Open Sans", Generic: "sans-serif", faces: []face{
+		{file: "OpenSans-Regular.woff2", weight: "100 400"},
+		{file: "OpenSans-Italic.woff2", weight: "100 900", italic: true},
+		{file: "OpenSans-SemiBold.woff2", weight: "500 600"},
+		{file: "OpenSans-Bold.woff2", weight: "700 900"},
+	}},
+	{Name: "Source Serif 4", Generic: "serif", faces: []face{
+		{file: "SourceSerif4-Regular.woff2", weight: "100 400"},
+		{file: "SourceSerif4-Italic.woff2", weight: "100 900", italic: true},
+		{file: "SourceSerif4-Semibold.woff2", weight: "500 600"},
+		{file: "SourceSerif4-Bold.woff2", weight: "700 900"},
+	}},
+	// Amiri is only shipped as TrueType, Chrome makes up the bold and italic faces
+	{Name: "Amiri", Generic: "serif", faces: []face{
+		{file: "Amiri-Regular.ttf", format: "truetype", weight: "100 900"},
+	}},
+}
+
+// coverage is read from the files once, a file we cannot read is a broken build.
+func init() {
+	for _, family := range families {
+		for i, face := range family.faces {
+			data, err := fs.ReadFile(FS, face.file)
+			if err != nil {
+				panic(err)
+			}
+			runes, err := readCoverage(data)
+			if err != nil {
+				panic(fmt.Sprintf("font %s: %v", face.file, err))
+			}
+			// a letter counts when every face of the family has it
+			if i == 0 {
+				family.coverage = runes
+				continue
+			}
+			for r := range family.coverage {
+				if !runes[r] {
+					delete(family.coverage, r)
+				}
+			}
+		}
+	}
+}
+
+// Lookup returns the bundled family with the given name.
+func Lookup(name string) (*Family, bool) {
+	for _, family := range families {
+		if family.Name == name {
+			return family, true
+		}
+	}
+	return nil, false
+}
+
+// Names returns the names of the bundled families sorted alphabetically.
+func Names() []string {
+	names := make([]string, 0, len(families))
+	for _, family := range families {
+		names = append(names, family.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Stack returns the CSS font-family value of a bundled family, it falls back to
+// Fallback, to the script fallbacks and then to the generic family.
+func (f *Family) Stack() string {
+	var stack []string
+	for _, name := range append([]string{f.Name, Fallback}, scriptFallbacks...) {
+		if name = "'" + name + "'"; !containsString(stack, name) {
+			stack = append(stack, name)
+		}
+	}
+	return strings.Join(append(stack, f.Generic), ", ")
+}
+
+// FaceRules returns the @font-face rules of every bundled family. Chrome only
+// downloads the faces a document uses.
+func FaceRules() string {
+	var b strings.Builder
+	for _, family := range families {
+		for _, face := range family.faces {
+			style := "normal"
+			if face.italic {
+				style = "italic"
+			}
+			format := face.format
+			if format == "" {
+				format = "woff2"
+			}
+			fmt.Fprintf(&b, "@font-face {\n        font-family: '%s';\n        src: url('%s%s') format('%s');\n        font-weight: %s;\n        font-style: %s;\n        font-display: block;\n    }\n    ",
+				family.Name, Origin, face.file, format, face.weight, style)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// Missing returns the letters of the language that cannot be rendered with the families,
+// each of them backed by the fallbacks as in their stack. Languages we have no alphabet
+// for are not checked.
+func Missing(lang string, names ...string) ([]rune, error) {
+	var fallbacks []*Family
+	for _, name := range append([]string{Fallback}, scriptFallbacks...) {
+		family, _ := Lookup(name)
+		fallbacks = append(fallbacks, family)
+	}
+
+	var missing []rune
+	for _, name := range names {
+		family, ok := Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("font family %q is not bundled", name)
+		}
+		for _, r := range alphabet(lang) {
+			if !covered(r, append([]*Family{family}, fallbacks...)) && !containsRune(missing, r) {
+				missing = append(missing, r)
+			}
+		}
+	}
+
+	return missing, nil
+}
+
+func covered(r rune, families []*Family) bool {
+	for _, family := range families {
+		if family.coverage[r] {
+			return true
+		}
+	}
+	return false
+}
+
+func containsRune(runes []rune, r rune) bool {
+	for _, v := range runes {
+		if v == r {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func mustSub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
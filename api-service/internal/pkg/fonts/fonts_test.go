@@ -0,0 +1,184 @@
+package fonts
+
+import (
+	"encoding/binary"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+// TestBundledFamiliesCoverAlphabets checks that a resume in any language we have an
+// alphabet for prints with any bundled family, backed by the fallbacks of its stack.
+func TestBundledFamiliesCoverAlphabets(t *testing.T) {
+	for key, letters := range alphabets {
+		if got := alphabet(key); got != letters {
+			t.Errorf("alphabet(%q) does not find the alphabet of %s", key, key)
+		}
+
+		for _, name := range Names() {
+			missing, err := Missing(key, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(missing) > 0 {
+				t.Errorf("%s misses %q of %s", name, string(missing), key)
+			}
+		}
+	}
+}
+
+func TestMissing(t *testing.T) {
+	// German is not checked yet, its alphabet gets a letter no bundled font has
+	alphabets["de-Latn"] = latin + "ÄÖÜäöüß" + "\U0001F600"
+	defer delete(alphabets, "de-Latn")
+
+	missing, err := Missing("de", "Source Serif 4", "Amiri")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(missing) != "\U0001F600" {
+		t.Errorf("Missing() = %q, want only the letter no bundled font has", string(missing))
+	}
+
+	// Amiri has no Cyrillic, Fira Sans in its stack has
+	if missing, err := Missing("ru", "Amiri"); err != nil || len(missing) > 0 {
+		t.Errorf("Missing(ru, Amiri) = %q, %v, the fallback covers Cyrillic", string(missing), err)
+	}
+	if missing, err := Missing("ja", "Open Sans"); err != nil || len(missing) > 0 {
+		t.Errorf("Missing(ja) = %q, %v, languages without alphabet are not checked", string(missing), err)
+	}
+	if _, err := Missing("en", "Comic Sans MS"); err == nil {
+		t.Error("Missing() accepted a family that is not bundled")
+	}
+}
+
+// cmapFormat4 builds a cmap table with a single format 4 subtable mapping first to last.
+func cmapFormat4(first, last uint16) []byte {
+	be := binary.BigEndian
+	cmap := make([]byte, 12)
+	be.PutUint16(cmap[2:], 1)
+	be.PutUint16(cmap[4:], 3)
+	be.PutUint16(cmap[6:], 1)
+	be.PutUint32(cmap[8:], 12)
+
+	// two segments, the second one is the 0xFFFF terminator, deltas and range offsets stay zero
+	subtable := make([]byte, 16+8*2)
+	be.PutUint16(subtable, 4)
+	be.PutUint16(subtable[2:], uint16(len(subtable)))
+	be.PutUint16(subtable[6:], 4)
+	be.PutUint16(subtable[14:], last)
+	be.PutUint16(subtable[16:], 0xffff)
+	be.PutUint16(subtable[20:], first)
+	be.PutUint16(subtable[22:], 0xffff)
+
+	return append(cmap, subtable...)
+}
+
+// cmapFormat12 builds a cmap table with a single format 12 subtable mapping first to last.
+func cmapFormat12(first, last uint32) []byte {
+	be := binary.BigEndian
+	cmap := make([]byte, 12)
+	be.PutUint16(cmap[2:], 1)
+	be.PutUint16(cmap[4:], 3)
+	be.PutUint16(cmap[6:], 10)
+	be.PutUint32(cmap[8:], 12)
+
+	subtable := make([]byte, 16+12)
+	be.PutUint16(subtable, 12)
+	be.PutUint32(subtable[4:], uint32(len(subtable)))
+	be.PutUint32(subtable[12:], 1)
+	be.PutUint32(subtable[16:], first)
+	be.PutUint32(subtable[20:], last)
+
+	return append(cmap, subtable...)
+}
+
+func TestParseCmap(t *testing.T) {
+	tests := []struct {
+		name string
+		cmap []byte
+		want string
+	}{
+		{name: "format 4", cmap: cmapFormat4('A', 'C'), want: "ABC"},
+		{name: "format 12", cmap: cmapFormat12(0x1F600, 0x1F601), want: "\U0001F600\U0001F601"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coverage, err := parseCmap(tt.cmap)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(coverage) != len([]rune(tt.want)) {
+				t.Errorf("parseCmap() covers %d characters, want %d", len(coverage), len([]rune(tt.want)))
+			}
+			for _, r := range tt.want {
+				if !coverage[r] {
+					t.Errorf("parseCmap() misses %q", r)
+				}
+			}
+
+			// every cut through the records or the subtable is reported
+			for n := 0; n < len(tt.cmap); n++ {
+				if _, err := parseCmap(tt.cmap[:n]); err == nil {
+					t.Errorf("parseCmap() read the first %d bytes of %d", n, len(tt.cmap))
+				}
+			}
+		})
+	}
+}
+
+func TestReadCoverageTruncated(t *testing.T) {
+	entries, err := fs.ReadDir(FS, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), ".woff2") && !strings.HasSuffix(entry.Name(), ".ttf") {
+			continue
+		}
+		data, err := fs.ReadFile(FS, entry.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run(entry.Name(), func(t *testing.T) {
+			if _, err := readCoverage(data); err != nil {
+				t.Fatal(err)
+			}
+
+			cuts := []int{0, 3, 4, sfntHeaderSize, sfntHeaderSize + 1}
+			if strings.HasSuffix(entry.Name(), ".woff2") {
+				cuts = append(cuts, woff2HeaderSize-1, woff2HeaderSize, woff2HeaderSize+1, len(data)/2, len(data)-1)
+			} else {
+				// the tables of a TrueType font other than the cmap are not read
+				cuts = append(cuts, sfntCmapEnd(t, data)-1)
+			}
+			for _, n := range cuts {
+				if _, err := readCoverage(data[:n]); err == nil {
+					t.Errorf("readCoverage() read the first %d bytes of %d", n, len(data))
+				}
+			}
+			// what the directory leads to must never be sliced beyond the data
+			for n := 0; n < min(len(data), 4096); n++ {
+				_, _ = readCoverage(data[:n])
+			}
+		})
+	}
+}
+
+// sfntCmapEnd returns the offset following the cmap table of a TrueType font.
+func sfntCmapEnd(t *testing.T, data []byte) int {
+	t.Helper()
+
+	be := binary.BigEndian
+	for i := 0; i < int(be.Uint16(data[4:])); i++ {
+		record := data[sfntHeaderSize+sfntRecordSize*i:]
+		if string(record[:4]) == "cmap" {
+			return int(be.Uint32(record[8:]) + be.Uint32(record[12:]))
+		}
+	}
+	t.Fatal("no cmap table")
+	return 0
+}
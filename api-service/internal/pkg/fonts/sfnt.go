@@ -0,0 +1,42 @@
+package fonts
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	sfntHeaderSize = 12
+	sfntRecordSize = 16
+)
+
+// sfntVersions are the versions of the uncompressed fonts we read, TrueType outlines
+// and CFF ones.
+var sfntVersions = []string{"\x00\x01\x00\x00", "true", "OTTO"}
+
+// readSFNTCoverage reads the cmap of an uncompressed TrueType or OpenType font.
+func readSFNTCoverage(data []byte) (map[rune]bool, error) {
+	be := binary.BigEndian
+	if len(data) < sfntHeaderSize || !containsString(sfntVersions, string(data[:4])) {
+		return nil, errors.New("not a WOFF2 or TrueType font")
+	}
+
+	numTables := int(be.Uint16(data[4:]))
+	if len(data) < sfntHeaderSize+sfntRecordSize*numTables {
+		return nil, errors.New("truncated table directory")
+	}
+	for i := 0; i < numTables; i++ {
+		record := data[sfntHeaderSize+sfntRecordSize*i:]
+		if string(record[:4]) != "cmap" {
+			continue
+		}
+		offset, length := uint64(be.Uint32(record[8:])), uint64(be.Uint32(record[12:]))
+		if offset+length > uint64(len(data)) {
+			return nil, errors.New("truncated cmap table")
+		}
+		return parseCmap(data[offset : offset+length])
+	}
+
+	return nil, errors.New("no cmap table")
+}
@@ -0,0 +1,178 @@
+package fonts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/andybalholm/brotli"
+	"github.com/pkg/errors"
+)
+
+const (
+	woff2Signature  = "wOF2"
+	woff2HeaderSize = 48
+	// customTag marks a table directory entry carrying its own tag
+	customTag = 0x3f
+)
+
+// knownTags are the table tags a WOFF2 directory refers to by index.
+var knownTags = []string{
+	"cmap", "head", "hhea", "hmtx", "maxp", "name", "OS/2", "post", "cvt ", "fpgm", "glyf", "loca", "prep",
+	"CFF ", "VORG", "EBDT", "EBLC", "gasp", "hdmx", "kern", "LTSH", "PCLT", "VDMX", "vhea", "vmtx", "BASE",
+	"GDEF", "GPOS", "GSUB", "EBSC", "JSTF", "MATH", "CBDT", "CBLC", "COLR", "CPAL", "SVG ", "sbix", "acnt",
+	"avar", "bdat", "bloc", "bsln", "cvar", "fdsc", "feat", "fmtx", "fvar", "gvar", "hsty", "just", "lcar",
+	"mort", "morx", "opbd", "prop", "trak", "Zapf", "Silf", "Glat", "Gloc", "Feat", "Sill",
+}
+
+// readCoverage returns the characters a WOFF2 or TrueType font has a glyph for, read
+// from its cmap table.
+func readCoverage(data []byte) (map[rune]bool, error) {
+	if len(data) >= 4 && string(data[:4]) == woff2Signature {
+		return readWOFF2Coverage(data)
+	}
+	return readSFNTCoverage(data)
+}
+
+// readWOFF2Coverage reads the cmap of a WOFF2 font, WOFF2 never transforms it, it only
+// has to be decompressed.
+func readWOFF2Coverage(data []byte) (map[rune]bool, error) {
+	if len(data) < woff2HeaderSize {
+		return nil, errors.New("truncated WOFF2 header")
+	}
+	if uint64(len(data)) < uint64(binary.BigEndian.Uint32(data[8:])) {
+		return nil, errors.New("truncated WOFF2 file")
+	}
+	numTables := int(binary.BigEndian.Uint16(data[12:]))
+	compressedSize := int(binary.BigEndian.Uint32(data[20:]))
+
+	directory := bytes.NewReader(data[woff2HeaderSize:])
+	var offset, cmapOffset, cmapLength uint32
+	found := false
+	for i := 0; i < numTables; i++ {
+		flags, err := directory.ReadByte()
+		if err != nil {
+			return nil, errors.Wrap(err, "table directory")
+		}
+
+		var tag string
+		if flags&customTag == customTag {
+			var raw [4]byte
+			if _, err := io.ReadFull(directory, raw[:]); err != nil {
+				return nil, errors.Wrap(err, "table directory")
+			}
+			tag = string(raw[:])
+		} else {
+			tag = knownTags[flags&customTag]
+		}
+
+		length, err := readBase128(directory)
+		if err != nil {
+			return nil, err
+		}
+		// glyf and loca are transformed by default, the other tables when asked to
+		version := flags >> 6
+		isGlyf := tag == "glyf" || tag == "loca"
+		if isGlyf && version == 0 || !isGlyf && version != 0 {
+			if length, err = readBase128(directory); err != nil {
+				return nil, err
+			}
+		}
+
+		if tag == "cmap" {
+			cmapOffset, cmapLength, found = offset, length, true
+		}
+		offset += length
+	}
+	if !found {
+		return nil, errors.New("no cmap table")
+	}
+
+	start := len(data) - directory.Len()
+	if start+compressedSize > len(data) {
+		return nil, errors.New("truncated font data")
+	}
+	// the lengths are read from the file, their sum must not wrap around
+	cmapEnd := int64(cmapOffset) + int64(cmapLength)
+	tables, err := io.ReadAll(io.LimitReader(brotli.NewReader(bytes.NewReader(data[start:start+compressedSize])), cmapEnd))
+	if err != nil {
+		return nil, errors.Wrap(err, "decompress")
+	}
+	if int64(len(tables)) < cmapEnd {
+		return nil, errors.New("truncated cmap table")
+	}
+
+	return parseCmap(tables[cmapOffset:cmapEnd])
+}
+
+// parseCmap reads the Unicode subtables of format 4 and 12.
+func parseCmap(cmap []byte) (map[rune]bool, error) {
+	be := binary.BigEndian
+	if len(cmap) < 4 {
+		return nil, errors.New("truncated cmap table")
+	}
+
+	coverage := make(map[rune]bool)
+	numTables := int(be.Uint16(cmap[2:]))
+	for i := 0; i < numTables; i++ {
+		record := 4 + 8*i
+		if record+8 > len(cmap) {
+			return nil, errors.New("truncated cmap table")
+		}
+		offset := uint64(be.Uint32(cmap[record+4:]))
+		if offset+2 > uint64(len(cmap)) {
+			return nil, errors.New("truncated cmap subtable")
+		}
+		subtable := cmap[offset:]
+
+		switch be.Uint16(subtable) {
+		case 4:
+			if len(subtable) < 14 {
+				return nil, errors.New("truncated cmap subtable")
+			}
+			// the end codes, a pad, the start codes, the deltas and the range offsets
+			segments := int(be.Uint16(subtable[6:])) / 2
+			if len(subtable) < 16+8*segments {
+				return nil, errors.New("truncated cmap subtable")
+			}
+			for s := 0; s < segments; s++ {
+				end := uint32(be.Uint16(subtable[14+2*s:]))
+				begin := uint32(be.Uint16(subtable[16+2*segments+2*s:]))
+				for r := begin; r <= end && r != 0xffff; r++ {
+					coverage[rune(r)] = true
+				}
+			}
+		case 12:
+			if len(subtable) < 16 {
+				return nil, errors.New("truncated cmap subtable")
+			}
+			groups := int(be.Uint32(subtable[12:]))
+			if len(subtable) < 16+12*groups {
+				return nil, errors.New("truncated cmap subtable")
+			}
+			for g := 0; g < groups; g++ {
+				begin, end := be.Uint32(subtable[16+12*g:]), be.Uint32(subtable[20+12*g:])
+				for r := begin; r <= end && r <= 0x10ffff; r++ {
+					coverage[rune(r)] = true
+				}
+			}
+		}
+	}
+
+	return coverage, nil
+}
+
+func readBase128(r io.ByteReader) (uint32, error) {
+	var value uint32
+	for i := 0; i < 5; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, errors.Wrap(err, "table directory")
+		}
+		value = value<<7 | uint32(b&0x7f)
+		if b&0x80 == 0 {
+			return value, nil
+		}
+	}
+	return 0, errors.New("table directory: UIntBase128 longer than 5 bytes")
+}
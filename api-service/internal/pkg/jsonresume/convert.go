@@ -0,0 +1,344 @@
+package jsonresume
+
+import (
+	"encoding/json"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
+	"github.com/pkg/errors"
+)
+
+// Import validates a JSON Resume document and maps it onto models.Resume.
+func Import(data []byte) (models.Resume, error) {
+	if err := Validate(data); err != nil {
+		return models.Resume{}, err
+	}
+
+	// values the schema accepts can still overflow the Go types, e.g. a huge experience_year
+	var document Document
+	if err := json.Unmarshal(data, &document); err != nil {
+		return models.Resume{}, errorpkg.NewErrBadRequest(errors.Wrap(err, "Import - Unmarshal"))
+	}
+
+	return ToResume(document), nil
+}
+
+// Export returns resumeData as an indented JSON Resume document.
+func Export(resumeData models.Resume) ([]byte, error) {
+	data, err := json.MarshalIndent(FromResume(resumeData), "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "Export - MarshalIndent")
+	}
+
+	return data, nil
+}
+
+// ToResume maps a document onto models.Resume.
+func ToResume(document Document) models.Resume {
+	basics := document.Basics
+
+	resumeData := models.Resume{
+		Basics: models.Basics{
+			Name:    basics.Name,
+			Label:   basics.Label,
+			Image:   basics.Image,
+			Email:   basics.Email,
+			Phone:   basics.Phone,
+			Summary: basics.Summary,
+			Location: models.Location{
+				Address:     basics.Location.Address,
+				PostalCode:  basics.Location.PostalCode,
+				City:        basics.Location.City,
+				CountryCode: basics.Location.CountryCode,
+				Region:      basics.Location.Region,
+			},
+			URL:            basics.URL,
+			Salary:         basics.Salary,
+			JobLocation:    basics.JobLocation,
+			JobType:        basics.JobType,
+			ExperienceYear: basics.ExperienceYear,
+		},
+		Meta: models.Meta{
+			Template:     document.Meta.Template,
+			Lang:         document.Meta.Lang,
+			Canonical:    document.Meta.Canonical,
+			Version:      document.Meta.Version,
+			LastModified: document.Meta.LastModified,
+		},
+	}
+	if document.Meta.Page != nil {
+		resumeData.Meta.Page = *document.Meta.Page
+	}
+	if document.Meta.Theme != nil {
+		resumeData.Meta.Theme = *document.Meta.Theme
+	}
+	if document.Meta.Layout != nil {
+		resumeData.Meta.Layout = *document.Meta.Layout
+	}
+	if document.Meta.PDF != nil {
+		resumeData.Meta.PDF = *document.Meta.PDF
+	}
+	if document.Meta.QR != nil {
+		resumeData.Meta.QR = *document.Meta.QR
+	}
+
+	for _, profile := range basics.Profiles {
+		resumeData.Basics.Profiles = append(resumeData.Basics.Profiles, models.Profile(profile))
+	}
+
+	for _, work := range document.Work {
+		resumeData.Work = append(resumeData.Work, models.Work{
+			Position:     work.Position,
+			Company:      work.Name,
+			URL:          work.URL,
+			Description:  work.Description,
+			StartDate:    work.StartDate,
+			EndDate:      work.EndDate,
+			Summary:      work.Summary,
+			Highlights:   work.Highlights,
+			Location:     work.Location,
+			Skills:       work.Skills,
+			ContractType: work.ContractType,
+		})
+	}
+
+	for _, volunteer := range document.Volunteer {
+		resumeData.Volunteer = append(resumeData.Volunteer, models.Volunteer(volunteer))
+	}
+
+	for _, education := range document.Education {
+		resumeData.Education = append(resumeData.Education, models.Education{
+			Institution: education.Institution,
+			URL:         education.URL,
+			Area:        education.Area,
+			StudyType:   education.StudyType,
+			Location:    education.Location,
+			StartDate:   education.StartDate,
+			EndDate:     education.EndDate,
+			Score:       education.Score,
+			Courses:     education.Courses,
+		})
+	}
+
+	for _, award := range document.Awards {
+		resumeData.Awards = append(resumeData.Awards, models.Award(award))
+	}
+
+	for _, certificate := range document.Certificates {
+		resumeData.Certificates = append(resumeData.Certificates, models.Certificate{
+			Title:  certificate.Name,
+			Date:   certificate.Date,
+			Issuer: certificate.Issuer,
+			Score:  certificate.Score,
+			URL:    certificate.URL,
+		})
+	}
+
+	for _, publication := range document.Publications {
+		resumeData.Publications = append(resumeData.Publications, models.Publication(publication))
+	}
+
+	for _, skill := range document.Skills {
+		resumeData.Skills = append(resumeData.Skills, models.Skill(skill))
+	}
+
+	for _, skill := range document.Meta.SoftSkills {
+		resumeData.SoftSkills = append(resumeData.SoftSkills, models.Skill(skill))
+	}
+
+	for _, section := range document.Meta.CustomSections {
+		custom := models.CustomSection{Title: section.Title}
+		for _, entry := range section.Entries {
+			custom.Entries = append(custom.Entries, models.CustomEntry(entry))
+		}
+		resumeData.CustomSections = append(resumeData.CustomSections, custom)
+	}
+
+	for _, language := range document.Languages {
+		resumeData.Languages = append(resumeData.Languages, models.Language(language))
+	}
+
+	for _, interest := range document.Interests {
+		resumeData.Interests = append(resumeData.Interests, models.Interest(interest))
+	}
+
+	for _, reference := range document.References {
+		resumeData.References = append(resumeData.References, models.Reference(reference))
+	}
+
+	for _, project := range document.Projects {
+		resumeData.Projects = append(resumeData.Projects, models.Project{
+			Name:        project.Name,
+			Description: project.Description,
+			URL:         project.URL,
+			Highlights:  project.Highlights,
+			Keywords:    project.Keywords,
+			StartDate:   project.StartDate,
+			EndDate:     project.EndDate,
+			Roles:       project.Roles,
+			Entity:      project.Entity,
+			Type:        project.Type,
+		})
+	}
+
+	return resumeData
+}
+
+// FromResume maps models.Resume onto a document, ToResume reverses it. Dates and URLs
+// are rewritten in the forms the schema requires.
+func FromResume(resumeData models.Resume) Document {
+	basics := resumeData.Basics
+
+	document := Document{
+		Schema: SchemaURL,
+		Basics: Basics{
+			Name:    basics.Name,
+			Label:   basics.Label,
+			Image:   basics.Image,
+			Email:   basics.Email,
+			Phone:   basics.Phone,
+			URL:     basics.URL,
+			Summary: basics.Summary,
+			Location: Location{
+				Address:     basics.Location.Address,
+				PostalCode:  basics.Location.PostalCode,
+				City:        basics.Location.City,
+				CountryCode: basics.Location.CountryCode,
+				Region:      basics.Location.Region,
+			},
+			Salary:         basics.Salary,
+			JobLocation:    basics.JobLocation,
+			JobType:        basics.JobType,
+			ExperienceYear: basics.ExperienceYear,
+		},
+		Meta: Meta{
+			Canonical:    resumeData.Meta.Canonical,
+			Version:      resumeData.Meta.Version,
+			LastModified: resumeData.Meta.LastModified,
+			Template:     resumeData.Meta.Template,
+			Lang:         resumeData.Meta.Lang,
+		},
+	}
+	if resumeData.Meta.Page != (models.PageLayout{}) {
+		page := resumeData.Meta.Page
+		document.Meta.Page = &page
+	}
+	if resumeData.Meta.Theme != (models.Theme{}) {
+		theme := resumeData.Meta.Theme
+		document.Meta.Theme = &theme
+	}
+	if layout := resumeData.Meta.Layout; len(layout.Order) > 0 || len(layout.Hidden) > 0 || len(layout.Columns) > 0 {
+		document.Meta.Layout = &layout
+	}
+	if resumeData.Meta.PDF != (models.PDFOptions{}) {
+		options := resumeData.Meta.PDF
+		document.Meta.PDF = &options
+	}
+	if resumeData.Meta.QR != (models.QROptions{}) {
+		options := resumeData.Meta.QR
+		document.Meta.QR = &options
+	}
+
+	for _, profile := range basics.Profiles {
+		document.Basics.Profiles = append(document.Basics.Profiles, Profile(profile))
+	}
+
+	for _, work := range resumeData.Work {
+		document.Work = append(document.Work, Work{
+			Name:         work.Company,
+			Location:     work.Location,
+			Description:  work.Description,
+			Position:     work.Position,
+			URL:          work.URL,
+			StartDate:    work.StartDate,
+			EndDate:      work.EndDate,
+			Summary:      work.Summary,
+			Highlights:   work.Highlights,
+			Skills:       work.Skills,
+			ContractType: work.ContractType,
+		})
+	}
+
+	for _, volunteer := range resumeData.Volunteer {
+		document.Volunteer = append(document.Volunteer, Volunteer(volunteer))
+	}
+
+	for _, education := range resumeData.Education {
+		document.Education = append(document.Education, Education{
+			Institution: education.Institution,
+			URL:         education.URL,
+			Area:        education.Area,
+			StudyType:   education.StudyType,
+			StartDate:   education.StartDate,
+			EndDate:     education.EndDate,
+			Score:       education.Score,
+			Courses:     education.Courses,
+			Location:    education.Location,
+		})
+	}
+
+	for _, award := range resumeData.Awards {
+		document.Awards = append(document.Awards, Award(award))
+	}
+
+	for _, certificate := range resumeData.Certificates {
+		document.Certificates = append(document.Certificates, Certificate{
+			Name:   certificate.Title,
+			Date:   certificate.Date,
+			URL:    certificate.URL,
+			Issuer: certificate.Issuer,
+			Score:  certificate.Score,
+		})
+	}
+
+	for _, publication := range resumeData.Publications {
+		document.Publications = append(document.Publications, Publication(publication))
+	}
+
+	for _, skill := range resumeData.Skills {
+		document.Skills = append(document.Skills, Skill(skill))
+	}
+
+	for _, skill := range resumeData.SoftSkills {
+		document.Meta.SoftSkills = append(document.Meta.SoftSkills, Skill(skill))
+	}
+
+	for _, section := range resumeData.CustomSections {
+		custom := CustomSection{Title: section.Title}
+		for _, entry := range section.Entries {
+			custom.Entries = append(custom.Entries, CustomEntry(entry))
+		}
+		document.Meta.CustomSections = append(document.Meta.CustomSections, custom)
+	}
+
+	for _, language := range resumeData.Languages {
+		document.Languages = append(document.Languages, Language(language))
+	}
+
+	for _, interest := range resumeData.Interests {
+		document.Interests = append(document.Interests, Interest(interest))
+	}
+
+	for _, reference := range resumeData.References {
+		document.References = append(document.References, Reference(reference))
+	}
+
+	for _, project := range resumeData.Projects {
+		document.Projects = append(document.Projects, Project{
+			Name:        project.Name,
+			Description: project.Description,
+			Highlights:  project.Highlights,
+			Keywords:    project.Keywords,
+			StartDate:   project.StartDate,
+			EndDate:     project.EndDate,
+			URL:         project.URL,
+			Roles:       project.Roles,
+			Entity:      project.Entity,
+			Type:        project.Type,
+		})
+	}
+
+	normalize(&document)
+
+	return document
+}
@@ -0,0 +1,123 @@
+package jsonresume
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+func TestExportImportsBack(t *testing.T) {
+	data, err := os.ReadFile("../../../examples/example.resume.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resumeData models.Resume
+	if err := json.Unmarshal(data, &resumeData); err != nil {
+		t.Fatal(err)
+	}
+
+	exported, err := Export(resumeData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := Import(exported)
+	if err != nil {
+		t.Fatalf("Import(Export()) = %v", err)
+	}
+
+	if imported.Basics.URL != "https://jdoerust.com" {
+		t.Errorf("basics.url = %q, want it absolute", imported.Basics.URL)
+	}
+	if got := imported.Work[0].StartDate; got != "2020-10" {
+		t.Errorf("work[0].startDate = %q, want 2020-10", got)
+	}
+	if got := imported.Education[0].EndDate; got != "2015" {
+		t.Errorf("education[0].endDate = %q, want 2015", got)
+	}
+	if imported.Basics.Name != resumeData.Basics.Name || len(imported.Work) != len(resumeData.Work) || len(imported.Skills) != len(resumeData.Skills) {
+		t.Error("the resume lost data on the way")
+	}
+
+	// once normalized, a resume goes through unchanged
+	again, err := Export(imported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(again, exported) {
+		t.Errorf("a second round trip changed the document:\n%s\nwant\n%s", again, exported)
+	}
+}
+
+func TestExportKeepsUnreadableValues(t *testing.T) {
+	exported, err := Export(models.Resume{
+		Basics: models.Basics{Name: "John Doe", URL: "my website"},
+		Work:   []models.Work{{Company: "Go Corp", StartDate: "Summer 2020", EndDate: "Present"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var document Document
+	if err := json.Unmarshal(exported, &document); err != nil {
+		t.Fatal(err)
+	}
+	if document.Basics.URL != "my website" {
+		t.Errorf("basics.url = %q, want it as typed", document.Basics.URL)
+	}
+	if work := document.Work[0]; work.StartDate != "Summer 2020" || work.EndDate != "" {
+		t.Errorf("work[0] dates = %q - %q, want the start as typed and no end", work.StartDate, work.EndDate)
+	}
+
+	// the schema points at what has to be fixed before the document imports back
+	if _, err := Import(exported); err == nil {
+		t.Error("Import() accepted a date the schema does not allow")
+	}
+}
+
+func TestExportDate(t *testing.T) {
+	tests := map[string]string{
+		"":                     "",
+		"2020":                 "2020",
+		"2020-10":              "2020-10",
+		"2020-10-05":           "2020-10-05",
+		"October 2020":         "2020-10",
+		"october 2020":         "2020-10",
+		"Oct 2020":             "2020-10",
+		"10/2020":              "2020-10",
+		"October 5, 2020":      "2020-10-05",
+		"2020-10-05T08:00:00Z": "2020-10-05",
+		"Present":              "",
+		"now":                  "",
+		" Today ":              "",
+		"sometime":             "sometime",
+		"Summer 2020":          "Summer 2020",
+	}
+
+	for date, want := range tests {
+		if got := exportDate(date); got != want {
+			t.Errorf("exportDate(%q) = %q, want %q", date, got, want)
+		}
+	}
+}
+
+func TestExportURL(t *testing.T) {
+	tests := map[string]string{
+		"":                      "",
+		"https://jdoe.com":      "https://jdoe.com",
+		"jdoe.com":              "https://jdoe.com",
+		"www.jdoe.com/projects": "https://www.jdoe.com/projects",
+		"//jdoe.com":            "https://jdoe.com",
+		"mailto:jdoe@mail.com":  "mailto:jdoe@mail.com",
+		"my website":            "my website",
+		"nothing":               "nothing",
+	}
+
+	for link, want := range tests {
+		if got := exportURL(link); got != want {
+			t.Errorf("exportURL(%q) = %q, want %q", link, got, want)
+		}
+	}
+}
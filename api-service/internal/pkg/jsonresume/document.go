@@ -0,0 +1,188 @@
+package jsonresume
+
+import "github.com/dostonshernazarov/resume_maker/api-service/api/models"
+
+// SchemaURL identifies the version of the JSON Resume schema documents are checked against.
+const SchemaURL = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// Document is a JSON Resume v1 document. Fields of models.Resume missing from the
+// standard are kept as extra properties, which the schema allows on these objects,
+// so an exported resume imports back without loss.
+type Document struct {
+	Schema       string        `json:"$schema,omitempty"`
+	Basics       Basics        `json:"basics"`
+	Work         []Work        `json:"work,omitempty"`
+	Volunteer    []Volunteer   `json:"volunteer,omitempty"`
+	Education    []Education   `json:"education,omitempty"`
+	Awards       []Award       `json:"awards,omitempty"`
+	Certificates []Certificate `json:"certificates,omitempty"`
+	Publications []Publication `json:"publications,omitempty"`
+	Skills       []Skill       `json:"skills,omitempty"`
+	Languages    []Language    `json:"languages,omitempty"`
+	Interests    []Interest    `json:"interests,omitempty"`
+	References   []Reference   `json:"references,omitempty"`
+	Projects     []Project     `json:"projects,omitempty"`
+	Meta         Meta          `json:"meta"`
+}
+
+type Basics struct {
+	Name     string    `json:"name,omitempty"`
+	Label    string    `json:"label,omitempty"`
+	Image    string    `json:"image,omitempty"`
+	Email    string    `json:"email,omitempty"`
+	Phone    string    `json:"phone,omitempty"`
+	URL      string    `json:"url,omitempty"`
+	Summary  string    `json:"summary,omitempty"`
+	Location Location  `json:"location"`
+	Profiles []Profile `json:"profiles,omitempty"`
+
+	Salary         uint64 `json:"salary,omitempty"`
+	JobLocation    string `json:"job_location,omitempty"`
+	JobType        string `json:"job_type,omitempty"`
+	ExperienceYear int32  `json:"experience_year,omitempty"`
+}
+
+type Location struct {
+	Address     string `json:"address,omitempty"`
+	PostalCode  string `json:"postalCode,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+type Profile struct {
+	Network  string `json:"network,omitempty"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+type Work struct {
+	Name        string   `json:"name,omitempty"`
+	Location    string   `json:"location,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Position    string   `json:"position,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Summary     string   `json:"summary,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+
+	Skills       []string `json:"skills,omitempty"`
+	ContractType string   `json:"contract_type,omitempty"`
+}
+
+type Volunteer struct {
+	Organization string   `json:"organization,omitempty"`
+	Position     string   `json:"position,omitempty"`
+	URL          string   `json:"url,omitempty"`
+	StartDate    string   `json:"startDate,omitempty"`
+	EndDate      string   `json:"endDate,omitempty"`
+	Summary      string   `json:"summary,omitempty"`
+	Highlights   []string `json:"highlights,omitempty"`
+}
+
+type Education struct {
+	Institution string   `json:"institution,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Area        string   `json:"area,omitempty"`
+	StudyType   string   `json:"studyType,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Score       string   `json:"score,omitempty"`
+	Courses     []string `json:"courses,omitempty"`
+
+	Location string `json:"location,omitempty"`
+}
+
+type Award struct {
+	Title   string `json:"title,omitempty"`
+	Date    string `json:"date,omitempty"`
+	Awarder string `json:"awarder,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+type Certificate struct {
+	Name   string `json:"name,omitempty"`
+	Date   string `json:"date,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Issuer string `json:"issuer,omitempty"`
+
+	Score string `json:"score,omitempty"`
+}
+
+type Publication struct {
+	Name        string `json:"name,omitempty"`
+	Publisher   string `json:"publisher,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Summary     string `json:"summary,omitempty"`
+}
+
+type CustomSection struct {
+	Title   string        `json:"title,omitempty"`
+	Entries []CustomEntry `json:"entries,omitempty"`
+}
+
+type CustomEntry struct {
+	Title      string   `json:"title,omitempty"`
+	Subtitle   string   `json:"subtitle,omitempty"`
+	Location   string   `json:"location,omitempty"`
+	URL        string   `json:"url,omitempty"`
+	StartDate  string   `json:"startDate,omitempty"`
+	EndDate    string   `json:"endDate,omitempty"`
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+type Skill struct {
+	Name     string   `json:"name,omitempty"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+type Language struct {
+	Language string `json:"language,omitempty"`
+	Fluency  string `json:"fluency,omitempty"`
+}
+
+type Interest struct {
+	Name     string   `json:"name,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+type Reference struct {
+	Name      string `json:"name,omitempty"`
+	Reference string `json:"reference,omitempty"`
+}
+
+type Project struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	Entity      string   `json:"entity,omitempty"`
+	Type        string   `json:"type,omitempty"`
+}
+
+// Meta carries the rendering settings of the resume next to the standard properties.
+// Soft skills live here as well since the schema forbids unknown top level properties.
+type Meta struct {
+	Canonical    string `json:"canonical,omitempty"`
+	Version      string `json:"version,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+
+	Template   string                `json:"template,omitempty"`
+	Lang       string                `json:"lang,omitempty"`
+	Page       *models.PageLayout    `json:"page,omitempty"`
+	Theme      *models.Theme         `json:"theme,omitempty"`
+	Layout     *models.SectionLayout `json:"layout,omitempty"`
+	PDF        *models.PDFOptions    `json:"pdf,omitempty"`
+	QR         *models.QROptions     `json:"qr,omitempty"`
+	SoftSkills []Skill               `json:"softSkills,omitempty"`
+	// CustomSections have no counterpart in the schema, they are kept here to survive a round trip
+	CustomSections []CustomSection `json:"customSections,omitempty"`
+}
@@ -0,0 +1,118 @@
+package jsonresume
+
+import (
+	"strings"
+	"time"
+)
+
+// exportLayouts are the ways dates are commonly typed into a resume, mapped to the
+// ISO 8601 layout of the same precision.
+var exportLayouts = []struct {
+	layout string
+	iso    string
+}{
+	{layout: time.RFC3339, iso: "2006-01-02"},
+	{layout: "January 2, 2006", iso: "2006-01-02"},
+	{layout: "2 January 2006", iso: "2006-01-02"},
+	{layout: "2006/01/02", iso: "2006-01-02"},
+	{layout: "02.01.2006", iso: "2006-01-02"},
+	{layout: "January 2006", iso: "2006-01"},
+	{layout: "January, 2006", iso: "2006-01"},
+	{layout: "Jan 2006", iso: "2006-01"},
+	{layout: "01/2006", iso: "2006-01"},
+	{layout: "1/2006", iso: "2006-01"},
+	{layout: "01.2006", iso: "2006-01"},
+	{layout: "2006/01", iso: "2006-01"},
+}
+
+// ongoingDates are typed as the end of an entry that is still going on, the schema
+// leaves the end date out instead.
+var ongoingDates = []string{"present", "now", "current", "ongoing", "today"}
+
+// normalize rewrites the dates and the URLs of document in the forms the schema
+// requires, so that an exported resume imports back. Values that cannot be rewritten
+// are kept as typed, losing them would lose what the user wrote.
+func normalize(document *Document) {
+	document.Basics.URL = exportURL(document.Basics.URL)
+	document.Meta.Canonical = exportURL(document.Meta.Canonical)
+	for i := range document.Basics.Profiles {
+		document.Basics.Profiles[i].URL = exportURL(document.Basics.Profiles[i].URL)
+	}
+
+	for i := range document.Work {
+		work := &document.Work[i]
+		work.URL = exportURL(work.URL)
+		work.StartDate, work.EndDate = exportDate(work.StartDate), exportDate(work.EndDate)
+	}
+	for i := range document.Volunteer {
+		volunteer := &document.Volunteer[i]
+		volunteer.URL = exportURL(volunteer.URL)
+		volunteer.StartDate, volunteer.EndDate = exportDate(volunteer.StartDate), exportDate(volunteer.EndDate)
+	}
+	for i := range document.Education {
+		education := &document.Education[i]
+		education.URL = exportURL(education.URL)
+		education.StartDate, education.EndDate = exportDate(education.StartDate), exportDate(education.EndDate)
+	}
+	for i := range document.Awards {
+		document.Awards[i].Date = exportDate(document.Awards[i].Date)
+	}
+	for i := range document.Certificates {
+		certificate := &document.Certificates[i]
+		certificate.URL = exportURL(certificate.URL)
+		certificate.Date = exportDate(certificate.Date)
+	}
+	for i := range document.Publications {
+		publication := &document.Publications[i]
+		publication.URL = exportURL(publication.URL)
+		publication.ReleaseDate = exportDate(publication.ReleaseDate)
+	}
+	for i := range document.Projects {
+		project := &document.Projects[i]
+		project.URL = exportURL(project.URL)
+		project.StartDate, project.EndDate = exportDate(project.StartDate), exportDate(project.EndDate)
+	}
+	for i := range document.Meta.CustomSections {
+		entries := document.Meta.CustomSections[i].Entries
+		for j := range entries {
+			entries[j].URL = exportURL(entries[j].URL)
+			entries[j].StartDate, entries[j].EndDate = exportDate(entries[j].StartDate), exportDate(entries[j].EndDate)
+		}
+	}
+}
+
+// exportDate writes date in ISO 8601 with the precision it was given, "October 2020"
+// becomes "2020-10". "Present" and the like are left out, other dates that cannot be
+// read are kept as typed.
+func exportDate(date string) string {
+	date = strings.TrimSpace(date)
+	if date == "" || iso8601.MatchString(date) {
+		return date
+	}
+	for _, l := range exportLayouts {
+		if t, err := time.Parse(l.layout, date); err == nil {
+			return t.Format(l.iso)
+		}
+	}
+	for _, ongoing := range ongoingDates {
+		if strings.EqualFold(date, ongoing) {
+			return ""
+		}
+	}
+	return date
+}
+
+// exportURL makes link absolute, a bare host such as "jdoe.com" is served over https.
+// Links that are not URLs are kept as typed.
+func exportURL(link string) string {
+	link = strings.TrimSpace(link)
+	if link == "" || checkFormat(link, formatURI) == "" {
+		return link
+	}
+
+	absolute := "https://" + strings.TrimPrefix(link, "//")
+	if strings.Contains(link, ".") && checkFormat(absolute, formatURI) == "" {
+		return absolute
+	}
+	return link
+}
@@ -0,0 +1,360 @@
+package jsonresume
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
+	val "github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/validation"
+	"github.com/pkg/errors"
+)
+
+// iso8601 is the date pattern of the schema, a year, a month or a day.
+var iso8601 = regexp.MustCompile(`^([1-2][0-9]{3}-[0-1][0-9]-[0-3][0-9]|[1-2][0-9]{3}-[0-1][0-9]|[1-2][0-9]{3})$`)
+
+type kind int
+
+const (
+	kindString kind = iota
+	kindNumber
+	kindInteger
+	kindBoolean
+	kindObject
+	kindArray
+)
+
+const (
+	formatDate  = "date"
+	formatURI   = "uri"
+	formatEmail = "email"
+)
+
+// node is the part of a JSON schema needed to check a resume document.
+type node struct {
+	kind       kind
+	format     string
+	properties map[string]*node
+	items      *node
+	// closed objects reject unknown properties, as additionalProperties: false does
+	closed bool
+}
+
+func str() *node                          { return &node{kind: kindString} }
+func date() *node                         { return &node{kind: kindString, format: formatDate} }
+func uri() *node                          { return &node{kind: kindString, format: formatURI} }
+func email() *node                        { return &node{kind: kindString, format: formatEmail} }
+func number() *node                       { return &node{kind: kindNumber} }
+func integer() *node                      { return &node{kind: kindInteger} }
+func boolean() *node                      { return &node{kind: kindBoolean} }
+func object(props map[string]*node) *node { return &node{kind: kindObject, properties: props} }
+func array(items *node) *node             { return &node{kind: kindArray, items: items} }
+
+func closed(n *node) *node {
+	n.closed = true
+	return n
+}
+
+// schema follows the JSON Resume v1.0.0 schema, extended with the properties of models.Resume.
+// Like the standard, only the document itself is closed to unknown properties.
+var schema = closed(object(map[string]*node{
+	"$schema": uri(),
+	"basics": object(map[string]*node{
+		"name":    str(),
+		"label":   str(),
+		"image":   str(),
+		"email":   email(),
+		"phone":   str(),
+		"url":     uri(),
+		"summary": str(),
+		"location": object(map[string]*node{
+			"address":     str(),
+			"postalCode":  str(),
+			"city":        str(),
+			"countryCode": str(),
+			"region":      str(),
+		}),
+		"profiles": array(object(map[string]*node{
+			"network":  str(),
+			"username": str(),
+			"url":      uri(),
+		})),
+		"salary":          integer(),
+		"job_location":    str(),
+		"job_type":        str(),
+		"experience_year": integer(),
+	}),
+	"work": array(object(map[string]*node{
+		"name":          str(),
+		"location":      str(),
+		"description":   str(),
+		"position":      str(),
+		"url":           uri(),
+		"startDate":     date(),
+		"endDate":       date(),
+		"summary":       str(),
+		"highlights":    array(str()),
+		"skills":        array(str()),
+		"contract_type": str(),
+	})),
+	"volunteer": array(object(map[string]*node{
+		"organization": str(),
+		"position":     str(),
+		"url":          uri(),
+		"startDate":    date(),
+		"endDate":      date(),
+		"summary":      str(),
+		"highlights":   array(str()),
+	})),
+	"education": array(object(map[string]*node{
+		"institution": str(),
+		"url":         uri(),
+		"area":        str(),
+		"studyType":   str(),
+		"startDate":   date(),
+		"endDate":     date(),
+		"score":       str(),
+		"courses":     array(str()),
+		"location":    str(),
+	})),
+	"awards": array(object(map[string]*node{
+		"title":   str(),
+		"date":    date(),
+		"awarder": str(),
+		"summary": str(),
+	})),
+	"certificates": array(object(map[string]*node{
+		"name":   str(),
+		"date":   date(),
+		"url":    uri(),
+		"issuer": str(),
+		"score":  str(),
+	})),
+	"publications": array(object(map[string]*node{
+		"name":        str(),
+		"publisher":   str(),
+		"releaseDate": date(),
+		"url":         uri(),
+		"summary":     str(),
+	})),
+	"skills":    array(skill),
+	"languages": array(object(map[string]*node{"language": str(), "fluency": str()})),
+	"interests": array(object(map[string]*node{"name": str(), "keywords": array(str())})),
+	"references": array(object(map[string]*node{
+		"name":      str(),
+		"reference": str(),
+	})),
+	"projects": array(object(map[string]*node{
+		"name":        str(),
+		"description": str(),
+		"highlights":  array(str()),
+		"keywords":    array(str()),
+		"startDate":   date(),
+		"endDate":     date(),
+		"url":         uri(),
+		"roles":       array(str()),
+		"entity":      str(),
+		"type":        str(),
+	})),
+	"meta": object(map[string]*node{
+		"canonical":    uri(),
+		"version":      str(),
+		"lastModified": str(),
+		"template":     str(),
+		"lang":         str(),
+		"page": object(map[string]*node{
+			"size":        str(),
+			"orientation": str(),
+			"margins": object(map[string]*node{
+				"top":    number(),
+				"right":  number(),
+				"bottom": number(),
+				"left":   number(),
+			}),
+			"header": str(),
+			"footer": str(),
+		}),
+		"theme": object(map[string]*node{
+			"accentColor": str(),
+			"fontFamily":  str(),
+			"fontSize":    number(),
+			"density":     str(),
+		}),
+		"layout": object(map[string]*node{
+			"order":   array(str()),
+			"hidden":  array(str()),
+			"columns": sectionColumns(),
+		}),
+		"pdf": object(map[string]*node{
+			"tagged":  boolean(),
+			"profile": str(),
+		}),
+		"qr": object(map[string]*node{
+			"target":  str(),
+			"network": str(),
+		}),
+		"softSkills": array(skill),
+		"customSections": array(object(map[string]*node{
+			"title": str(),
+			"entries": array(object(map[string]*node{
+				"title":      str(),
+				"subtitle":   str(),
+				"location":   str(),
+				"url":        uri(),
+				"startDate":  date(),
+				"endDate":    date(),
+				"summary":    str(),
+				"highlights": array(str()),
+			})),
+		})),
+	}),
+}))
+
+// sectionColumns accepts a column for every section, the values are checked when the resume is rendered.
+func sectionColumns() *node {
+	props := make(map[string]*node, len(models.Sections))
+	for _, section := range models.Sections {
+		props[section] = str()
+	}
+	return object(props)
+}
+
+var skill = object(map[string]*node{
+	"name":     str(),
+	"level":    str(),
+	"keywords": array(str()),
+})
+
+// Validate checks data against the schema. Every violation is reported in the
+// Errors of the returned ErrValidation, keyed by the path of the property.
+// Unknown properties of the nested objects are accepted and ignored, as the standard
+// allows them.
+func Validate(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return errorpkg.NewErrBadRequest(errors.Wrap(err, "invalid JSON"))
+	}
+	if decoder.More() {
+		return errorpkg.NewErrBadRequest(errors.New("invalid JSON: unexpected data after the document"))
+	}
+
+	errValidation := errorpkg.NewErrValidation()
+	check("", document, schema, errValidation.Errors)
+	if len(errValidation.Errors) == 0 {
+		return nil
+	}
+
+	errValidation.Err = errors.New(fmt.Sprintf("document does not match the JSON Resume schema: %d errors", len(errValidation.Errors)))
+	return errValidation
+}
+
+func check(path string, value interface{}, n *node, errs map[string]string) {
+	// null is accepted everywhere and decodes to the zero value
+	if value == nil {
+		return
+	}
+
+	switch n.kind {
+	case kindString:
+		s, ok := value.(string)
+		if !ok {
+			errs[fieldName(path)] = "must be a string"
+			return
+		}
+		if msg := checkFormat(s, n.format); msg != "" {
+			errs[fieldName(path)] = msg
+		}
+	case kindNumber, kindInteger:
+		num, ok := value.(json.Number)
+		if !ok {
+			errs[fieldName(path)] = "must be a number"
+			return
+		}
+		if n.kind == kindInteger {
+			if _, err := num.Int64(); err != nil {
+				errs[fieldName(path)] = "must be an integer"
+				return
+			}
+		}
+		if f, err := num.Float64(); err != nil || f < 0 {
+			errs[fieldName(path)] = "must not be negative"
+		}
+	case kindBoolean:
+		if _, ok := value.(bool); !ok {
+			errs[fieldName(path)] = "must be a boolean"
+		}
+	case kindArray:
+		items, ok := value.([]interface{})
+		if !ok {
+			errs[fieldName(path)] = "must be an array"
+			return
+		}
+		for i, item := range items {
+			check(fmt.Sprintf("%s[%d]", path, i), item, n.items, errs)
+		}
+	case kindObject:
+		props, ok := value.(map[string]interface{})
+		if !ok {
+			errs[fieldName(path)] = "must be an object"
+			return
+		}
+		names := make([]string, 0, len(props))
+		for name := range props {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			child := path + "." + name
+			if path == "" {
+				child = name
+			}
+			propNode, known := n.properties[name]
+			if !known {
+				if n.closed {
+					errs[child] = "unknown property"
+				}
+				continue
+			}
+			check(child, props[name], propNode, errs)
+		}
+	}
+}
+
+func checkFormat(s, format string) string {
+	// empty values mean the property is not set, e.g. the end date of a current position
+	if s == "" {
+		return ""
+	}
+
+	switch format {
+	case formatDate:
+		if !iso8601.MatchString(s) {
+			return "must be an ISO 8601 date: YYYY, YYYY-MM or YYYY-MM-DD"
+		}
+	case formatURI:
+		u, err := url.Parse(s)
+		if err != nil || u.Scheme == "" || strings.ContainsAny(s, " \t\n") {
+			return "must be an absolute URI"
+		}
+	case formatEmail:
+		if !val.IsValidEmail(s) {
+			return "must be an email address"
+		}
+	}
+	return ""
+}
+
+func fieldName(path string) string {
+	if path == "" {
+		return "document"
+	}
+	return path
+}
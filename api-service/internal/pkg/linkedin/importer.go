@@ -0,0 +1,249 @@
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxArchiveSize bounds the uploaded archive, a full LinkedIn export is a few megabytes
+	MaxArchiveSize = 20 << 20
+	// maxFileSize bounds every uncompressed CSV so a crafted archive can not exhaust memory
+	maxFileSize = 5 << 20
+	// maxTotalSize bounds the uncompressed CSVs together, they are all held in memory
+	maxTotalSize = 10 << 20
+
+	profileFile        = "profile.csv"
+	emailsFile         = "email addresses.csv"
+	phonesFile         = "phonenumbers.csv"
+	positionsFile      = "positions.csv"
+	educationFile      = "education.csv"
+	skillsFile         = "skills.csv"
+	languagesFile      = "languages.csv"
+	certificationsFile = "certifications.csv"
+	projectsFile       = "projects.csv"
+)
+
+// Import reads the ZIP archive of a LinkedIn data export and builds a resume draft from it.
+// Rows that could not be turned into a resume entry are returned as issues, files
+// missing from the archive are skipped since LinkedIn lets users export parts of their data.
+func Import(archive []byte) (models.Resume, []models.ImportIssue, error) {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return models.Resume{}, nil, errorpkg.NewErrBadRequest(errors.Wrap(err, "invalid ZIP archive"))
+	}
+
+	// the sizes in the headers are checked before anything is inflated
+	files := make(map[string]*zip.File)
+	var declared uint64
+	for _, f := range zr.File {
+		name := strings.ToLower(path.Base(f.Name))
+		if _, known := sources[name]; !known || f.FileInfo().IsDir() {
+			continue
+		}
+		if _, ok := files[name]; ok {
+			return models.Resume{}, nil, errorpkg.NewErrBadRequest(errors.New(fmt.Sprintf("the archive contains %s more than once", path.Base(f.Name))))
+		}
+		if f.UncompressedSize64 > maxFileSize {
+			return models.Resume{}, nil, errorpkg.NewErrBadRequest(errors.New(fmt.Sprintf("%s exceeds %d bytes", path.Base(f.Name), maxFileSize)))
+		}
+		declared += f.UncompressedSize64
+		files[name] = f
+	}
+
+	if len(files) == 0 {
+		return models.Resume{}, nil, errorpkg.NewErrBadRequest(errors.New("the archive does not contain any LinkedIn export file"))
+	}
+	if declared > maxTotalSize {
+		return models.Resume{}, nil, errorpkg.NewErrBadRequest(errors.New(fmt.Sprintf("the export files exceed %d bytes together", maxTotalSize)))
+	}
+
+	var (
+		resumeData models.Resume
+		issues     []models.ImportIssue
+		read       int
+	)
+	for _, name := range parseOrder {
+		f, ok := files[name]
+		if !ok {
+			continue
+		}
+
+		// the header sizes can lie, what is inflated is bounded as well
+		limit := maxFileSize
+		if remaining := maxTotalSize - read; remaining < limit {
+			limit = remaining
+		}
+		data, err := readFile(f, limit)
+		if err != nil {
+			return models.Resume{}, nil, errorpkg.NewErrBadRequest(errors.Wrap(err, path.Base(f.Name)))
+		}
+		read += len(data)
+
+		t, err := parseTable(data, sources[name].keyColumn)
+		if err != nil {
+			return models.Resume{}, nil, errorpkg.NewErrBadRequest(errors.Wrap(err, path.Base(f.Name)))
+		}
+		t.file = path.Base(f.Name)
+
+		if t.columns == nil {
+			issues = append(issues, models.ImportIssue{
+				File:   t.file,
+				Reason: fmt.Sprintf("no header with a %q column", sources[name].keyColumn),
+			})
+			continue
+		}
+		issues = append(issues, sources[name].parse(t, &resumeData)...)
+	}
+
+	return resumeData, issues, nil
+}
+
+// parseOrder keeps contact files after the profile, they fill in what it lacks.
+var parseOrder = []string{
+	profileFile,
+	emailsFile,
+	phonesFile,
+	positionsFile,
+	educationFile,
+	skillsFile,
+	languagesFile,
+	certificationsFile,
+	projectsFile,
+}
+
+// source describes one CSV of the export, keyColumn identifies its header row.
+type source struct {
+	keyColumn string
+	parse     func(t *table, resumeData *models.Resume) []models.ImportIssue
+}
+
+var sources = map[string]source{
+	profileFile:        {"First Name", parseProfile},
+	emailsFile:         {"Email Address", parseEmails},
+	phonesFile:         {"Number", parsePhones},
+	positionsFile:      {"Company Name", parsePositions},
+	educationFile:      {"School Name", parseEducation},
+	skillsFile:         {"Name", parseSkills},
+	languagesFile:      {"Name", parseLanguages},
+	certificationsFile: {"Name", parseCertifications},
+	projectsFile:       {"Title", parseProjects},
+}
+
+// table is a CSV file with its header, cells are looked up by column name.
+// columns is nil when no header was found.
+type table struct {
+	file    string
+	columns map[string]int
+	rows    [][]string
+	// lines holds the line number each row starts at, used in issues
+	lines []int
+}
+
+// readFile inflates f, it fails once more than limit bytes come out.
+func readFile(f *zip.File, limit int) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > limit {
+		return nil, errors.New(fmt.Sprintf("file exceeds %d bytes", limit))
+	}
+	return data, nil
+}
+
+// parseTable reads a CSV file and finds its header, the first record naming keyColumn.
+func parseTable(data []byte, keyColumn string) (*table, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var (
+		records [][]string
+		lines   []int
+	)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+
+	// some exports start with a "Notes:" paragraph, the header is the first record naming the key column
+	t := &table{}
+	for header, record := range records {
+		columns := make(map[string]int, len(record))
+		for i, column := range record {
+			columns[strings.ToLower(strings.TrimSpace(column))] = i
+		}
+		if _, ok := columns[strings.ToLower(keyColumn)]; !ok {
+			continue
+		}
+
+		t.columns = columns
+		t.rows = records[header+1:]
+		t.lines = lines[header+1:]
+		break
+	}
+
+	return t, nil
+}
+
+// get returns the trimmed cell of the column, empty when the column or the cell is missing.
+func (t *table) get(row []string, column string) string {
+	i, ok := t.columns[strings.ToLower(column)]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
+
+// each calls fn for every non-blank row and collects the reasons it returns as issues.
+func (t *table) each(fn func(row []string) string) []models.ImportIssue {
+	var issues []models.ImportIssue
+	for i, row := range t.rows {
+		if isBlank(row) {
+			continue
+		}
+		if reason := fn(row); reason != "" {
+			issues = append(issues, models.ImportIssue{
+				File:   t.file,
+				Line:   t.lines[i],
+				Reason: reason,
+			})
+		}
+	}
+	return issues
+}
+
+func isBlank(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,245 @@
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"hash/crc32"
+	"strings"
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+type archiveFile struct {
+	name    string
+	content string
+}
+
+// archive zips files in the given order, the way LinkedIn nests them in a folder.
+func archive(t *testing.T, files ...archiveFile) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, file := range files {
+		w, err := zw.Create(file.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(file.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestImport(t *testing.T) {
+	data := archive(t,
+		archiveFile{"Basic_LinkedInDataExport/Profile.csv", "\xef\xbb\xbfFirst Name,Last Name,Headline,Summary,Geo Location,Websites,Twitter Handles\n" +
+			`John,Doe,Go Developer,Builds APIs,"Paris, Ile-de-France",[PERSONAL:jdoe.com][BLOG:blog.jdoe.com],[@jdoe]` + "\n"},
+		archiveFile{"Basic_LinkedInDataExport/Email Addresses.csv", "Email Address,Confirmed,Primary\njohn@old.com,Yes,No\njohn@doe.com,Yes,Yes\nnot an email,No,No\n"},
+		archiveFile{"Basic_LinkedInDataExport/PhoneNumbers.csv", "Extension,Number,Type\n,+33 1 23 45 67 89,Work\n,+33 6 12 34 56 78,Mobile\n"},
+		archiveFile{"Basic_LinkedInDataExport/Positions.csv", "Company Name,Title,Description,Location,Started On,Finished On\nGo Corp,Developer,Wrote Go,Paris,Oct 2020,\n,,,,,\n,,Nothing,,,\n"},
+		archiveFile{"Basic_LinkedInDataExport/Education.csv", "School Name,Start Date,End Date,Notes,Degree Name,Activities\nAlta Nova,2013,2015,Finance,Master,Chess club\n"},
+		archiveFile{"Basic_LinkedInDataExport/Skills.csv", "Name\nGo\nKubernetes\n"},
+		archiveFile{"Basic_LinkedInDataExport/Languages.csv", "Name,Proficiency\nFrench,Native or bilingual proficiency\n"},
+		archiveFile{"Basic_LinkedInDataExport/Certifications.csv", "Name,Url,Authority,Started On,Finished On\nCKA,https://cncf.io,CNCF,Jan 2022,\n"},
+		archiveFile{"Basic_LinkedInDataExport/Projects.csv", "Title,Description,Url,Started On,Finished On\nResume maker,PDF resumes,github.com/jdoe/resume,Mar 2023,Jun 2023\n"},
+		archiveFile{"Basic_LinkedInDataExport/Connections.csv", "First Name,Last Name\nJane,Roe\n"},
+	)
+
+	resumeData, issues, err := Import(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	basics := resumeData.Basics
+	if basics.Name != "John Doe" || basics.Label != "Go Developer" || basics.Summary != "Builds APIs" {
+		t.Errorf("basics = %+v, want the profile", basics)
+	}
+	if basics.Location.City != "Paris" || basics.Location.Region != "Ile-de-France" {
+		t.Errorf("location = %+v, want Paris, Ile-de-France", basics.Location)
+	}
+	if basics.URL != "jdoe.com" || len(basics.Profiles) != 2 || basics.Profiles[0].URL != "blog.jdoe.com" || basics.Profiles[1].URL != "https://twitter.com/jdoe" {
+		t.Errorf("url = %q, profiles = %+v, want the first website and the others as profiles", basics.URL, basics.Profiles)
+	}
+	if basics.Email != "john@doe.com" {
+		t.Errorf("email = %q, want the primary address", basics.Email)
+	}
+	if basics.Phone != "+33 6 12 34 56 78" {
+		t.Errorf("phone = %q, want the mobile number", basics.Phone)
+	}
+
+	if len(resumeData.Work) != 1 || resumeData.Work[0].Position != "Developer" || resumeData.Work[0].StartDate != "2020-10" || resumeData.Work[0].EndDate != "" {
+		t.Errorf("work = %+v, want the developer position since 2020-10", resumeData.Work)
+	}
+	if len(resumeData.Education) != 1 || resumeData.Education[0].StudyType != "Master" || len(resumeData.Education[0].Courses) != 2 {
+		t.Errorf("education = %+v, want the master with notes and activities", resumeData.Education)
+	}
+	if len(resumeData.Skills) != 2 || len(resumeData.Languages) != 1 {
+		t.Errorf("skills = %+v, languages = %+v", resumeData.Skills, resumeData.Languages)
+	}
+	if len(resumeData.Certificates) != 1 || resumeData.Certificates[0].Date != "2022-01" {
+		t.Errorf("certificates = %+v, want CKA of 2022-01", resumeData.Certificates)
+	}
+	if len(resumeData.Projects) != 1 || resumeData.Projects[0].EndDate != "2023-06" {
+		t.Errorf("projects = %+v, want the resume maker until 2023-06", resumeData.Projects)
+	}
+
+	// blank rows are skipped, unusable ones are reported with their line
+	want := []models.ImportIssue{
+		{File: "Email Addresses.csv", Line: 4, Reason: "invalid email address"},
+		{File: "Positions.csv", Line: 4, Reason: "position has neither a title nor a company name"},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("issues = %+v, want %+v", issues, want)
+	}
+	for i := range want {
+		if issues[i] != want[i] {
+			t.Errorf("issues[%d] = %+v, want %+v", i, issues[i], want[i])
+		}
+	}
+}
+
+func TestParseTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		columns bool
+		rows    int
+		line    int
+	}{
+		{name: "header first", data: "Name\nGo\n", columns: true, rows: 1, line: 2},
+		{name: "byte order mark", data: "\xef\xbb\xbfName\nGo\n", columns: true, rows: 1, line: 2},
+		{name: "notes before the header", data: "Notes:\n\"Skills you added, one per line\"\n\nName\nGo\nRust\n", columns: true, rows: 2, line: 5},
+		{name: "header case and spaces", data: " NAME ,Level\nGo,Expert\n", columns: true, rows: 1, line: 2},
+		{name: "multiline cell", data: "Name,Description\nGo,\"line one\nline two\"\nRust,\n", columns: true, rows: 2, line: 2},
+		{name: "no header", data: "Skill\nGo\n"},
+		{name: "empty file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := parseTable([]byte(tt.data), "Name")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (table.columns != nil) != tt.columns {
+				t.Fatalf("columns = %v, want a header: %v", table.columns, tt.columns)
+			}
+			if len(table.rows) != tt.rows {
+				t.Errorf("%d rows, want %d", len(table.rows), tt.rows)
+			}
+			if tt.rows > 0 {
+				if table.lines[0] != tt.line {
+					t.Errorf("first row at line %d, want %d", table.lines[0], tt.line)
+				}
+				if got := table.get(table.rows[0], "name"); got == "" {
+					t.Error("get() of the key column is empty")
+				}
+				if got := table.get(table.rows[0], "Missing"); got != "" {
+					t.Errorf("get() of a missing column = %q", got)
+				}
+			}
+		})
+	}
+}
+
+func TestImportMissingHeader(t *testing.T) {
+	_, issues, err := Import(archive(t,
+		archiveFile{"Skills.csv", "Skill\nGo\n"},
+		archiveFile{"Languages.csv", "Name,Proficiency\nFrench,Native\n"},
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 1 || issues[0].File != "Skills.csv" || !strings.Contains(issues[0].Reason, `"Name"`) {
+		t.Errorf("issues = %+v, want the header of Skills.csv reported", issues)
+	}
+}
+
+func TestImportRejects(t *testing.T) {
+	big := strings.Repeat("a", maxFileSize+1)
+	large := "Name\n" + strings.Repeat("a", maxTotalSize/3)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "not an archive", data: []byte("Name\nGo\n")},
+		{name: "no export file", data: archive(t, archiveFile{"Connections.csv", "First Name\nJane\n"})},
+		{name: "duplicate file", data: archive(t, archiveFile{"export/Skills.csv", "Name\nGo\n"}, archiveFile{"skills.csv", "Name\nRust\n"})},
+		{name: "oversize file", data: archive(t, archiveFile{"Skills.csv", big})},
+		{name: "oversize export", data: archive(t, archiveFile{"Skills.csv", large}, archiveFile{"Languages.csv", large}, archiveFile{"Projects.csv", large}, archiveFile{"Positions.csv", large})},
+		{name: "lying header", data: lyingArchive(t, "Skills.csv", big)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Import(tt.data); err == nil {
+				t.Error("Import() succeeded, want an error")
+			}
+		})
+	}
+}
+
+// lyingArchive stores content under a header claiming it is a few bytes long.
+func lyingArchive(t *testing.T, name, content string) []byte {
+	t.Helper()
+
+	var deflated bytes.Buffer
+	fw, err := flate.NewWriter(&deflated, flate.BestCompression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fw.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := fw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.CreateRaw(&zip.FileHeader{
+		Name:               name,
+		Method:             zip.Deflate,
+		CRC32:              crc32.ChecksumIEEE([]byte(content)),
+		CompressedSize64:   uint64(deflated.Len()),
+		UncompressedSize64: 16,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(deflated.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestIsoDate(t *testing.T) {
+	tests := map[string]string{
+		"Oct 2020":     "2020-10",
+		"October 2020": "2020-10",
+		"2020":         "2020",
+		"Oct 5, 2020":  "2020-10-05",
+		"10/5/20":      "2020-10-05",
+		"":             "",
+		"Present":      "Present",
+	}
+
+	for value, want := range tests {
+		if got := isoDate(value); got != want {
+			t.Errorf("isoDate(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
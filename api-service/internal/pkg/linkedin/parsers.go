@@ -0,0 +1,226 @@
+package linkedin
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	val "github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/validation"
+)
+
+// dateLayouts are the formats met in LinkedIn exports and the ISO 8601 precision they map to.
+var dateLayouts = []struct {
+	layout string
+	iso    string
+}{
+	{"Jan 2006", "2006-01"},
+	{"January 2006", "2006-01"},
+	{"2006", "2006"},
+	{"Jan 2, 2006", "2006-01-02"},
+	{"2 Jan 2006", "2006-01-02"},
+	{"2006-01-02", "2006-01-02"},
+	{"1/2/06", "2006-01-02"},
+	{"1/2/2006", "2006-01-02"},
+}
+
+// websiteRe matches the "[TYPE:url]" entries of the Websites column.
+var websiteRe = regexp.MustCompile(`\[(?:[A-Z_]+:)?([^\]]+)\]`)
+
+// isoDate converts a LinkedIn date to ISO 8601, unknown formats are kept as they are.
+func isoDate(value string) string {
+	for _, format := range dateLayouts {
+		if t, err := time.Parse(format.layout, value); err == nil {
+			return t.Format(format.iso)
+		}
+	}
+	return value
+}
+
+func parseProfile(t *table, resumeData *models.Resume) []models.ImportIssue {
+	parsed := false
+
+	return t.each(func(row []string) string {
+		// the file has one row, more can only come from a hand-edited export
+		if parsed {
+			return "only the first profile is imported"
+		}
+		parsed = true
+
+		basics := &resumeData.Basics
+		basics.Name = strings.TrimSpace(t.get(row, "First Name") + " " + t.get(row, "Last Name"))
+		basics.Label = t.get(row, "Headline")
+		basics.Summary = t.get(row, "Summary")
+		basics.Location.Address = t.get(row, "Address")
+		basics.Location.PostalCode = t.get(row, "Zip Code")
+
+		// Geo Location reads "City, Region, Country"
+		if geo := t.get(row, "Geo Location"); geo != "" {
+			parts := strings.SplitN(geo, ",", 2)
+			basics.Location.City = strings.TrimSpace(parts[0])
+			if len(parts) == 2 {
+				basics.Location.Region = strings.TrimSpace(parts[1])
+			}
+		}
+
+		for _, match := range websiteRe.FindAllStringSubmatch(t.get(row, "Websites"), -1) {
+			website := strings.TrimSpace(match[1])
+			if basics.URL == "" {
+				basics.URL = website
+				continue
+			}
+			basics.Profiles = append(basics.Profiles, models.Profile{
+				Network: "Website",
+				URL:     website,
+			})
+		}
+
+		for _, match := range websiteRe.FindAllStringSubmatch(t.get(row, "Twitter Handles"), -1) {
+			handle := strings.TrimPrefix(strings.TrimSpace(match[1]), "@")
+			basics.Profiles = append(basics.Profiles, models.Profile{
+				Network:  "Twitter",
+				Username: handle,
+				URL:      "https://twitter.com/" + handle,
+			})
+		}
+
+		if basics.Name == "" {
+			return "first and last name are empty"
+		}
+		return ""
+	})
+}
+
+func parseEmails(t *table, resumeData *models.Resume) []models.ImportIssue {
+	return t.each(func(row []string) string {
+		email := t.get(row, "Email Address")
+		if !val.IsValidEmail(email) {
+			return "invalid email address"
+		}
+
+		// the primary address wins over the first one listed
+		if resumeData.Basics.Email == "" || strings.EqualFold(t.get(row, "Primary"), "Yes") {
+			resumeData.Basics.Email = email
+		}
+		return ""
+	})
+}
+
+func parsePhones(t *table, resumeData *models.Resume) []models.ImportIssue {
+	return t.each(func(row []string) string {
+		number := t.get(row, "Number")
+		if number == "" {
+			return "phone number is empty"
+		}
+
+		if resumeData.Basics.Phone == "" || strings.EqualFold(t.get(row, "Type"), "Mobile") {
+			resumeData.Basics.Phone = number
+		}
+		return ""
+	})
+}
+
+func parsePositions(t *table, resumeData *models.Resume) []models.ImportIssue {
+	return t.each(func(row []string) string {
+		work := models.Work{
+			Position:  t.get(row, "Title"),
+			Company:   t.get(row, "Company Name"),
+			Summary:   t.get(row, "Description"),
+			Location:  t.get(row, "Location"),
+			StartDate: isoDate(t.get(row, "Started On")),
+			EndDate:   isoDate(t.get(row, "Finished On")),
+		}
+		if work.Position == "" && work.Company == "" {
+			return "position has neither a title nor a company name"
+		}
+
+		resumeData.Work = append(resumeData.Work, work)
+		return ""
+	})
+}
+
+func parseEducation(t *table, resumeData *models.Resume) []models.ImportIssue {
+	return t.each(func(row []string) string {
+		education := models.Education{
+			Institution: t.get(row, "School Name"),
+			StudyType:   t.get(row, "Degree Name"),
+			Area:        t.get(row, "Field Of Study"),
+			StartDate:   isoDate(t.get(row, "Start Date")),
+			EndDate:     isoDate(t.get(row, "End Date")),
+		}
+		if education.Institution == "" {
+			return "school name is empty"
+		}
+
+		for _, column := range []string{"Notes", "Activities"} {
+			if value := t.get(row, column); value != "" {
+				education.Courses = append(education.Courses, value)
+			}
+		}
+
+		resumeData.Education = append(resumeData.Education, education)
+		return ""
+	})
+}
+
+func parseSkills(t *table, resumeData *models.Resume) []models.ImportIssue {
+	return t.each(func(row []string) string {
+		name := t.get(row, "Name")
+		if name == "" {
+			return "skill name is empty"
+		}
+
+		resumeData.Skills = append(resumeData.Skills, models.Skill{Name: name})
+		return ""
+	})
+}
+
+func parseLanguages(t *table, resumeData *models.Resume) []models.ImportIssue {
+	return t.each(func(row []string) string {
+		language := models.Language{
+			Language: t.get(row, "Name"),
+			Fluency:  t.get(row, "Proficiency"),
+		}
+		if language.Language == "" {
+			return "language name is empty"
+		}
+
+		resumeData.Languages = append(resumeData.Languages, language)
+		return ""
+	})
+}
+
+func parseCertifications(t *table, resumeData *models.Resume) []models.ImportIssue {
+	return t.each(func(row []string) string {
+		certificate := models.Certificate{
+			Title:  t.get(row, "Name"),
+			Issuer: t.get(row, "Authority"),
+			Date:   isoDate(t.get(row, "Started On")),
+			URL:    t.get(row, "Url"),
+		}
+		if certificate.Title == "" {
+			return "certification name is empty"
+		}
+
+		resumeData.Certificates = append(resumeData.Certificates, certificate)
+		return ""
+	})
+}
+
+func parseProjects(t *table, resumeData *models.Resume) []models.ImportIssue {
+	return t.each(func(row []string) string {
+		project := models.Project{
+			Name:        t.get(row, "Title"),
+			Description: t.get(row, "Description"),
+			URL:         t.get(row, "Url"),
+			StartDate:   isoDate(t.get(row, "Started On")),
+			EndDate:     isoDate(t.get(row, "Finished On")),
+		}
+		if project.Name == "" {
+			return "project title is empty"
+		}
+
+		resumeData.Projects = append(resumeData.Projects, project)
+		return ""
+	})
+}
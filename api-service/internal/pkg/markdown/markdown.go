@@ -0,0 +1,102 @@
+package markdown
+
+import (
+	"bytes"
+	"html/template"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// BlockClass is the class of the element wrapping text made of several paragraphs or
+// lists, templates style their lists with it.
+const BlockClass = "markdown"
+
+// converter only knows the syntax resumes use: emphasis, links and lists. Headings, code,
+// quotes and HTML stay as the user typed them and are escaped.
+var converter = goldmark.New(
+	goldmark.WithParser(parser.NewParser(
+		parser.WithBlockParsers(
+			util.Prioritized(parser.NewListParser(), 300),
+			util.Prioritized(parser.NewListItemParser(), 400),
+			util.Prioritized(parser.NewParagraphParser(), 1000),
+		),
+		parser.WithInlineParsers(
+			util.Prioritized(parser.NewLinkParser(), 200),
+			util.Prioritized(parser.NewAutoLinkParser(), 300),
+			util.Prioritized(parser.NewEmphasisParser(), 500),
+		),
+	)),
+	// a line break in a summary is meant to be one
+	goldmark.WithRendererOptions(html.WithHardWraps()),
+)
+
+// blockPolicy and inlinePolicy are strict allow-lists applied to the output of converter,
+// whatever it lets through.
+var (
+	blockPolicy  = newPolicy("p", "br", "strong", "em", "ul", "ol", "li")
+	inlinePolicy = newPolicy("br", "strong", "em")
+)
+
+func newPolicy(elements ...string) *bluemonday.Policy {
+	policy := bluemonday.NewPolicy()
+	policy.AllowElements(elements...)
+	for _, element := range elements {
+		if element == "ol" {
+			// an attribute alone is enough for bluemonday to keep an element
+			policy.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+		}
+	}
+	policy.AllowAttrs("href").OnElements("a")
+	policy.AllowURLSchemes("http", "https", "mailto")
+	policy.RequireParseableURLs(true)
+	policy.AllowRelativeURLs(false)
+	policy.AddTargetBlankToFullyQualifiedLinks(true)
+	return policy
+}
+
+// Block renders text written in markdown to sanitized HTML. A single paragraph is
+// rendered without its <p> so that it reads as before in the templates, anything longer
+// is wrapped in an element of class BlockClass.
+func Block(source string) template.HTML {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return ""
+	}
+
+	document := converter.Parser().Parse(text.NewReader([]byte(source)))
+	if document.ChildCount() == 1 && document.FirstChild().Kind() == ast.KindParagraph {
+		return render(source, document, inlinePolicy)
+	}
+	return template.HTML(`<div class="`+BlockClass+`">`) + render(source, document, blockPolicy) + "</div>"
+}
+
+// Inline renders text written in markdown to sanitized HTML allowed inside a paragraph or
+// a list item, the blocks are flattened to their text.
+func Inline(source string) template.HTML {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return ""
+	}
+
+	document := converter.Parser().Parse(text.NewReader([]byte(source)))
+	return render(source, document, inlinePolicy)
+}
+
+func render(source string, document ast.Node, policy *bluemonday.Policy) template.HTML {
+	var out bytes.Buffer
+	if err := converter.Renderer().Render(&out, []byte(source), document); err != nil {
+		// rendering to memory does not fail, escaping the source is still safe
+		return template.HTML(template.HTMLEscapeString(source))
+	}
+	// the renderer ends blocks and <br> with new lines, they would show twice in
+	// white-space: pre-line elements
+	html := bytes.ReplaceAll(out.Bytes(), []byte("\n"), []byte(" "))
+	return template.HTML(strings.TrimSpace(string(policy.SanitizeBytes(html))))
+}
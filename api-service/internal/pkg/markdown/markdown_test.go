@@ -0,0 +1,141 @@
+package markdown
+
+import (
+	"html/template"
+	"testing"
+)
+
+func TestBlock(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   template.HTML
+	}{
+		{
+			name:   "emphasis",
+			source: "Built **fast** APIs with _Go_",
+			want:   "Built <strong>fast</strong> APIs with <em>Go</em>",
+		},
+		{
+			name:   "line breaks",
+			source: "line one\nline two",
+			want:   "line one<br> line two",
+		},
+		{
+			name:   "links",
+			source: "[site](https://example.com) <mailto:me@example.com>",
+			want:   `<a href="https://example.com" target="_blank" rel="noopener">site</a> <a href="mailto:me@example.com">mailto:me@example.com</a>`,
+		},
+		{
+			name:   "lists",
+			source: "Intro\n\n- one\n- **two**\n\n3. three",
+			want:   `<div class="markdown"><p>Intro</p> <ul> <li>one</li> <li><strong>two</strong></li> </ul> <ol start="3"> <li>three</li> </ol></div>`,
+		},
+		{
+			name:   "unsupported syntax is kept as text",
+			source: "# Heading `code`",
+			want:   "# Heading `code`",
+		},
+		{
+			name:   "empty",
+			source: " \n ",
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Block(tt.source); got != tt.want {
+				t.Errorf("Block(%q) = %q, want %q", tt.source, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInlineFlattensBlocks(t *testing.T) {
+	got := Inline("Intro\n\n- one\n- **two**\n\n3. three")
+	want := template.HTML("Intro  one <strong>two</strong>   three")
+	if got != want {
+		t.Errorf("Inline() = %q, want %q", got, want)
+	}
+}
+
+func TestHostilePayloads(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   template.HTML
+	}{
+		{
+			name:   "script tag",
+			source: `<script>alert(1)</script>`,
+			want:   "&lt;script&gt;alert(1)&lt;/script&gt;",
+		},
+		{
+			name:   "event handler",
+			source: `<img src=x onerror=alert(1)>`,
+			want:   "&lt;img src=x onerror=alert(1)&gt;",
+		},
+		{
+			name:   "raw link with handler",
+			source: `<a href="https://example.com" onclick="alert(1)">x</a>`,
+			want:   "&lt;a href=&#34;https://example.com&#34; onclick=&#34;alert(1)&#34;&gt;x&lt;/a&gt;",
+		},
+		{
+			name:   "html inside emphasis",
+			source: `**<b onmouseover="alert(1)">bold</b>**`,
+			want:   "<strong>&lt;b onmouseover=&#34;alert(1)&#34;&gt;bold&lt;/b&gt;</strong>",
+		},
+		{
+			name:   "javascript link",
+			source: `[click](javascript:alert(1))`,
+			want:   "click",
+		},
+		{
+			name:   "javascript link with mixed case and spaces",
+			source: `[click](  JaVaScRiPt:alert(1))`,
+			want:   "click",
+		},
+		{
+			name:   "data link",
+			source: `[click](data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==)`,
+			want:   "click",
+		},
+		{
+			name:   "vbscript autolink",
+			source: `<vbscript:msgbox(1)>`,
+			want:   "vbscript:msgbox(1)",
+		},
+		{
+			name:   "protocol relative link",
+			source: `[click](//evil.example.com)`,
+			want:   "click",
+		},
+		{
+			name:   "attribute breakout in link title",
+			source: `[click](https://example.com "x\" onmouseover=\"alert(1)")`,
+			want:   `<a href="https://example.com" target="_blank" rel="noopener">click</a>`,
+		},
+		{
+			name:   "remote image",
+			source: `![tracker](https://evil.example.com/pixel.png)`,
+			want:   "",
+		},
+		{
+			name:   "closing the surrounding element",
+			source: `</div><iframe src="https://evil.example.com"></iframe>`,
+			want:   "&lt;/div&gt;&lt;iframe src=&#34;https://evil.example.com&#34;&gt;&lt;/iframe&gt;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Block(tt.source); got != tt.want {
+				t.Errorf("Block(%q) = %q, want %q", tt.source, got, tt.want)
+			}
+			if got := Inline(tt.source); got != tt.want {
+				t.Errorf("Inline(%q) = %q, want %q", tt.source, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,57 +1,75 @@
 package parser
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/logger"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
-	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/fs"
 	"github.com/pkg/errors"
 	"time"
 )
 
 type HTMLParser struct {
-	OutputDir      string
-	OutputHtmlFile string
-	TmplManager    *template.Manager
+	TmplManager *template.Manager
 }
 
-func NewHTMLParser(outputDir, outputHtmlFile string, templateMgr *template.Manager) *HTMLParser {
+func NewHTMLParser(templateMgr *template.Manager) *HTMLParser {
 	return &HTMLParser{
-		OutputDir:      outputDir,
-		OutputHtmlFile: outputHtmlFile,
-		TmplManager:    templateMgr,
+		TmplManager: templateMgr,
 	}
 }
 
-func (p *HTMLParser) ParseToHtml(resumeData models.Resume) (string, error) {
+// ParseToHtml renders the resume in memory, so concurrent calls never share output.
+func (p *HTMLParser) ParseToHtml(resumeData models.Resume) ([]byte, error) {
 	startedAt := time.Now()
 
-	fs.EnsureDir(p.OutputDir)
+	if err := p.updateResumeLabels(&resumeData); err != nil {
+		return nil, err
+	}
 
-	htmlOut, err := fs.CreateFile(p.OutputHtmlFile)
+	t, err := p.TmplManager.GetTemplate(resumeData.Meta.Template)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	defer htmlOut.Close()
 
-	if err := p.updateResumeLabels(&resumeData); err != nil {
-		return "", err
+	var htmlOut bytes.Buffer
+	err = t.Execute(&htmlOut, resumeData)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("ParseToHtml %s - Execute", resumeData.Meta.Template))
 	}
 
-	t, err := p.TmplManager.GetTemplate(resumeData.Meta.Template)
+	logger.Error(errors.New(fmt.Sprintf("HTML %s generated in %f seconds", resumeData.Meta.Template, time.Since(startedAt).Seconds())))
+
+	return htmlOut.Bytes(), nil
+}
+
+// ParseCoverLetterToHtml renders the cover letter with the letter layout of its template.
+func (p *HTMLParser) ParseCoverLetterToHtml(letter models.CoverLetter) ([]byte, error) {
+	startedAt := time.Now()
+
+	if letter.Meta.Template == "" {
+		letter.Meta.Template = models.ClassicTemplate
+	}
+	letter.Labels.Greeting = letter.GetGreetingLabel()
+	letter.Labels.Closing = letter.GetClosingLabel()
+	// the paragraphs replace the summary the partials print with the basics
+	letter.Basics.Summary = ""
+
+	t, err := p.TmplManager.GetLetterTemplate(letter.Meta.Template)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	err = t.Execute(htmlOut, resumeData)
+	var htmlOut bytes.Buffer
+	err = t.Execute(&htmlOut, letter)
 	if err != nil {
-		return "", errors.Wrap(err, fmt.Sprintf("ParseToHtml %s - Execute", resumeData.Meta.Template))
+		return nil, errors.Wrap(err, fmt.Sprintf("ParseCoverLetterToHtml %s - Execute", letter.Meta.Template))
 	}
 
-	logger.Error(errors.New(fmt.Sprintf("HTML %s generated in %f seconds", htmlOut.Name(), time.Since(startedAt).Seconds())))
+	logger.Error(errors.New(fmt.Sprintf("Cover letter HTML %s generated in %f seconds", letter.Meta.Template, time.Since(startedAt).Seconds())))
 
-	return htmlOut.Name(), nil
+	return htmlOut.Bytes(), nil
 }
 
 func (p *HTMLParser) updateResumeLabels(resumeData *models.Resume) error {
@@ -64,6 +82,11 @@ func (p *HTMLParser) updateResumeLabels(resumeData *models.Resume) error {
 	resumeData.Labels.Interests = resumeData.GetInterestsLabel()
 	resumeData.Labels.Profile = resumeData.GetProfileLabel()
 	resumeData.Labels.Since = resumeData.GetSinceLabel()
+	resumeData.Labels.Certifications = resumeData.GetCertificationsLabel()
+	resumeData.Labels.Volunteer = resumeData.GetVolunteerLabel()
+	resumeData.Labels.Awards = resumeData.GetAwardsLabel()
+	resumeData.Labels.Publications = resumeData.GetPublicationsLabel()
+	resumeData.Labels.References = resumeData.GetReferencesLabel()
 
 	if resumeData.Meta.Template == "" {
 		resumeData.Meta.Template = models.ClassicTemplate
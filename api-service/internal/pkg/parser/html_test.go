@@ -0,0 +1,259 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/fonts"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/qrcode"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
+)
+
+var candidatePattern = regexp.MustCompile(`candidate-\d{3}@example\.com`)
+
+func TestParseToHtmlConcurrentJobsDoNotShareOutput(t *testing.T) {
+	templateManager, err := template.NewTemplateManager("../../../ui")
+	if err != nil {
+		t.Fatal(err)
+	}
+	htmlParser := NewHTMLParser(templateManager)
+	templates := []string{"basic", "classic", "oldman", "simple"}
+
+	const jobs = 64
+	var wg sync.WaitGroup
+	errs := make(chan error, jobs)
+
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			email := fmt.Sprintf("candidate-%03d@example.com", i)
+			html, err := htmlParser.ParseToHtml(models.Resume{
+				Basics: models.Basics{
+					Name:  fmt.Sprintf("Candidate %03d", i),
+					Email: email,
+				},
+				Meta: models.Meta{
+					Template: templates[i%len(templates)],
+					Lang:     "en",
+				},
+			})
+			if err != nil {
+				errs <- fmt.Errorf("job %d: %v", i, err)
+				return
+			}
+
+			found := candidatePattern.FindAllString(string(html), -1)
+			if len(found) == 0 {
+				errs <- fmt.Errorf("job %d: own email %s missing from output", i, email)
+				return
+			}
+			for _, other := range found {
+				if other != email {
+					errs <- fmt.Errorf("job %d: output contains %s from another job", i, other)
+					return
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+// hostilePayloads are written in every free text field, none of their markers may reach
+// the rendered HTML.
+var hostilePayloads = map[string]string{
+	"<script>alert(1)</script>":                          "<script>alert(1)",
+	"<img src=x onerror=alert(2)>":                       "<img src=x onerror",
+	"[click](javascript:alert(3))":                       "javascript:alert(3)",
+	"<a href=https://example.com onclick=alert(4)>x</a>": "<a href=https://example.com onclick",
+	"</div><svg onload=alert(5)>":                        "<svg onload",
+}
+
+func TestParseToHtmlSanitizesFreeText(t *testing.T) {
+	templateManager, err := template.NewTemplateManager("../../../ui")
+	if err != nil {
+		t.Fatal(err)
+	}
+	htmlParser := NewHTMLParser(templateManager)
+
+	var payload strings.Builder
+	for source := range hostilePayloads {
+		payload.WriteString(source + "\n\n")
+	}
+	text := payload.String()
+
+	for _, name := range []string{"basic", "classic", "oldman", "simple"} {
+		t.Run(name, func(t *testing.T) {
+			html, err := htmlParser.ParseToHtml(models.Resume{
+				Basics:   models.Basics{Name: "Candidate", Summary: text},
+				Projects: []models.Project{{Name: "Project", Description: text, Highlights: []string{text}}},
+				Volunteer: []models.Volunteer{{
+					Organization: "Organization", Summary: text, Highlights: []string{text},
+				}},
+				CustomSections: []models.CustomSection{{
+					Title:   "Talks",
+					Entries: []models.CustomEntry{{Title: "Talk", Summary: text, Highlights: []string{text}}},
+				}},
+				Meta: models.Meta{Template: name, Lang: "en"},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, marker := range hostilePayloads {
+				if strings.Contains(string(html), marker) {
+					t.Errorf("output contains %s", marker)
+				}
+			}
+			if !strings.Contains(string(html), "&lt;script&gt;") {
+				t.Errorf("escaped payload missing from output")
+			}
+		})
+	}
+}
+
+func TestParseCoverLetterToHtml(t *testing.T) {
+	templateManager, err := template.NewTemplateManager("../../../ui")
+	if err != nil {
+		t.Fatal(err)
+	}
+	htmlParser := NewHTMLParser(templateManager)
+
+	var payload strings.Builder
+	for source := range hostilePayloads {
+		payload.WriteString(source + " ")
+	}
+
+	tests := []struct {
+		lang      string
+		recipient string
+		want      []string
+	}{
+		{lang: "en", recipient: "Jane Doe", want: []string{"Dear Jane Doe,", "June 15, 2024", "Sincerely,"}},
+		{lang: "en", want: []string{"Dear Hiring Manager,"}},
+		{lang: "ru", recipient: "Иван", want: []string{"Здравствуйте, Иван!", "С уважением,"}},
+	}
+
+	for _, name := range []string{"basic", "classic", "oldman", "simple"} {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.lang+"/"+tt.recipient, func(t *testing.T) {
+				html, err := htmlParser.ParseCoverLetterToHtml(models.CoverLetter{
+					Basics: models.Basics{
+						Name:    "Candidate Name",
+						Email:   "candidate@example.com",
+						Summary: "Summary of the resume",
+					},
+					Recipient:  tt.recipient,
+					Company:    "Acme Inc.",
+					Date:       "2024-06-15",
+					Paragraphs: []string{"I am **writing** to apply.", payload.String()},
+					Meta:       models.Meta{Template: name, Lang: tt.lang},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				want := append([]string{"Candidate Name", "candidate@example.com", "Acme Inc.", "I am <strong>writing</strong> to apply."}, tt.want...)
+				for _, s := range want {
+					if !strings.Contains(string(html), s) {
+						t.Errorf("output lacks %q", s)
+					}
+				}
+				if strings.Contains(string(html), "Summary of the resume") {
+					t.Errorf("output contains the summary of the resume")
+				}
+				for _, marker := range hostilePayloads {
+					if strings.Contains(string(html), marker) {
+						t.Errorf("output contains %s", marker)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestParseToHtmlPrintsQRCode(t *testing.T) {
+	templateManager, err := template.NewTemplateManager("../../../ui")
+	if err != nil {
+		t.Fatal(err)
+	}
+	htmlParser := NewHTMLParser(templateManager)
+
+	svg, err := qrcode.SVG("https://example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"basic", "classic", "oldman", "simple"} {
+		t.Run(name, func(t *testing.T) {
+			resume := models.Resume{
+				Basics: models.Basics{Name: "Jane Doe", Email: "jane@example.com"},
+				Meta:   models.Meta{Template: name, Lang: "en"},
+			}
+
+			html, err := htmlParser.ParseToHtml(resume)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(string(html), `class="qr-code"`) {
+				t.Error("QR code printed without being asked for")
+			}
+
+			resume.Basics.QRCode = svg
+			html, err = htmlParser.ParseToHtml(resume)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(html), string(svg)) {
+				t.Error("QR code missing from the contact block")
+			}
+		})
+	}
+}
+
+var remoteResource = regexp.MustCompile(`(?i)(?:src=["']?|url\(["']?)(https?:)?//[^"')\s>]+`)
+
+func TestParseToHtmlLoadsNoRemoteResource(t *testing.T) {
+	templateManager, err := template.NewTemplateManager("../../../ui")
+	if err != nil {
+		t.Fatal(err)
+	}
+	htmlParser := NewHTMLParser(templateManager)
+
+	for _, name := range []string{"basic", "classic", "oldman", "simple"} {
+		t.Run(name, func(t *testing.T) {
+			html, err := htmlParser.ParseToHtml(models.Resume{
+				Basics: models.Basics{
+					Name:     "Jane Doe",
+					Image:    "data:image/png;base64,iVBORw0KGgo=",
+					Profiles: []models.Profile{{Network: "GitHub", URL: "https://github.com/jane"}, {Network: "LinkedIn", URL: "https://linkedin.com/in/jane"}},
+				},
+				Meta: models.Meta{Template: name, Lang: "en"},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Chrome blocks every request but those to the bundled fonts
+			for _, resource := range remoteResource.FindAllString(string(html), -1) {
+				if !strings.Contains(resource, fonts.Origin) {
+					t.Errorf("template loads %s", resource)
+				}
+			}
+			if strings.Contains(strings.ToLower(string(html)), "<script src") {
+				t.Error("template loads a remote script")
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package pdf
+
+import (
+	"context"
+	"encoding/base64"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/cdproto/fetch"
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/chromedp"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// assetTypes completes the MIME types of the standard library for the assets we serve.
+var assetTypes = map[string]string{
+	".woff2": "font/woff2",
+	".ttf":   "font/ttf",
+}
+
+// interceptRequests answers every request of a tab, those to the asset origin from the
+// asset files and the others with an error. Documents are injected into about:blank and
+// their images inlined, the browser never reaches the network.
+func (p *Pool) interceptRequests(tabCtx context.Context) error {
+	chromedp.ListenTarget(tabCtx, func(ev interface{}) {
+		if paused, ok := ev.(*fetch.EventRequestPaused); ok {
+			// the listener must not block, answering is a round trip to the browser
+			go p.fulfill(tabCtx, paused)
+		}
+	})
+
+	err := chromedp.Run(tabCtx, fetch.Enable().WithPatterns([]*fetch.RequestPattern{
+		{URLPattern: "*"},
+	}))
+	return errors.Wrap(err, "Pool.interceptRequests - fetch.Enable")
+}
+
+func (p *Pool) fulfill(tabCtx context.Context, ev *fetch.EventRequestPaused) {
+	ctx := cdp.WithExecutor(tabCtx, chromedp.FromContext(tabCtx).Target)
+
+	if p.cfg.Assets == nil || !strings.HasPrefix(ev.Request.URL, p.cfg.AssetOrigin) {
+		p.logger.Warn("chrome pool: network request blocked", zap.String("url", ev.Request.URL))
+		if err := fetch.FailRequest(ev.RequestID, network.ErrorReasonBlockedByClient).Do(ctx); err != nil && tabCtx.Err() == nil {
+			p.logger.Error("chrome pool: failed to block request", zap.Error(err))
+		}
+		return
+	}
+
+	var name string
+	if u, err := url.Parse(ev.Request.URL); err == nil {
+		name = strings.TrimPrefix(u.Path, "/")
+	}
+	data, err := fs.ReadFile(p.cfg.Assets, name)
+	if err != nil {
+		p.logger.Error("chrome pool: unknown asset", zap.String("url", ev.Request.URL))
+		if err := fetch.FailRequest(ev.RequestID, network.ErrorReasonFailed).Do(ctx); err != nil && tabCtx.Err() == nil {
+			p.logger.Error("chrome pool: failed to reject asset request", zap.Error(err))
+		}
+		return
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if t, ok := assetTypes[path.Ext(name)]; ok {
+		contentType = t
+	}
+
+	err = fetch.FulfillRequest(ev.RequestID, http.StatusOK).
+		WithResponseHeaders([]*fetch.HeaderEntry{
+			{Name: "Content-Type", Value: contentType},
+			// documents are loaded in about:blank, fonts are fetched cross-origin
+			{Name: "Access-Control-Allow-Origin", Value: "*"},
+		}).
+		WithBody(base64.StdEncoding.EncodeToString(data)).
+		Do(ctx)
+	if err != nil && tabCtx.Err() == nil {
+		p.logger.Error("chrome pool: failed to serve asset", zap.String("url", ev.Request.URL), zap.Error(err))
+	}
+}
@@ -0,0 +1,200 @@
+package pdf
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	pdfDateLayout = "D:20060102150405Z"
+	xmpDateLayout = "2006-01-02T15:04:05Z"
+)
+
+// DocumentOptions describe the document inside the PDF. Chrome only writes its own name
+// and the dates, the rest is added as an incremental update once it printed the PDF.
+type DocumentOptions struct {
+	Title    string
+	Author   string
+	Subject  string
+	Keywords []string
+	// Lang is the language screen readers read the document in
+	Lang string
+	// Tagged asks Chrome for the structure tree screen readers navigate
+	Tagged bool
+	// Profile is models.PDFProfileDefault or models.PDFProfileA2B
+	Profile   string
+	CreatedAt time.Time
+}
+
+// NewDocumentOptions validates the PDF options of resume meta and describes the resume
+// with its basics and skills.
+func NewDocumentOptions(resumeData models.Resume, now time.Time) (DocumentOptions, error) {
+	switch resumeData.Meta.PDF.Profile {
+	case models.PDFProfileDefault, models.PDFProfileA2B:
+	default:
+		return DocumentOptions{}, errors.New(fmt.Sprintf("unknown PDF profile %q", resumeData.Meta.PDF.Profile))
+	}
+
+	name := strings.TrimSpace(resumeData.Basics.Name)
+	label := strings.TrimSpace(resumeData.Basics.Label)
+
+	// the title reads like the <title> of the templates
+	title := name
+	if title != "" && label != "" {
+		title += " - "
+	}
+	title += label
+
+	var keywords []string
+	seen := make(map[string]bool)
+	for _, skill := range resumeData.Skills {
+		keyword := strings.TrimSpace(skill.Name)
+		if keyword == "" || seen[strings.ToLower(keyword)] {
+			continue
+		}
+		seen[strings.ToLower(keyword)] = true
+		keywords = append(keywords, keyword)
+	}
+
+	return DocumentOptions{
+		Title:     title,
+		Author:    name,
+		Subject:   label,
+		Keywords:  keywords,
+		Lang:      resumeData.Meta.Lang,
+		Tagged:    resumeData.Meta.PDF.Tagged,
+		Profile:   resumeData.Meta.PDF.Profile,
+		CreatedAt: now,
+	}, nil
+}
+
+// apply appends the metadata, the language and, for PDF/A, the output intent to the
+// PDF printed by Chrome. The information dictionary and the XMP metadata carry the same
+// values as PDF/A requires, Chrome stays the creator and the producer.
+func (o DocumentOptions) apply(data []byte) ([]byte, error) {
+	f, err := readFile(data)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogNumber, catalogGeneration, ok := parseReference(f.trailer.get("/Root"))
+	if !ok {
+		return nil, errors.New("PDF trailer has no catalog")
+	}
+	catalog, _, err := f.object(catalogNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	u := newUpdate(f)
+
+	info := dict{}
+	infoNumber, infoGeneration, ok := parseReference(f.trailer.get("/Info"))
+	if ok {
+		if info, infoGeneration, err = f.object(infoNumber); err != nil {
+			return nil, err
+		}
+	} else {
+		infoNumber, infoGeneration = u.newObject(), 0
+	}
+	creator, _ := decodeText(info.get("/Creator"))
+	producer, _ := decodeText(info.get("/Producer"))
+
+	for _, entry := range []dictEntry{
+		{key: "/Title", value: o.Title},
+		{key: "/Author", value: o.Author},
+		{key: "/Subject", value: o.Subject},
+		{key: "/Keywords", value: strings.Join(o.Keywords, ", ")},
+	} {
+		if entry.value == "" {
+			info.delete(entry.key)
+			continue
+		}
+		info.set(entry.key, encodeText(entry.value))
+	}
+	info.set("/CreationDate", encodeText(o.CreatedAt.UTC().Format(pdfDateLayout)))
+	info.set("/ModDate", encodeText(o.CreatedAt.UTC().Format(pdfDateLayout)))
+	u.write(infoNumber, infoGeneration, info.String())
+
+	metadataNumber := u.newObject()
+	u.writeStream(metadataNumber, 0, dict{{key: "/Type", value: "/Metadata"}, {key: "/Subtype", value: "/XML"}}, o.xmp(creator, producer))
+	catalog.set("/Metadata", reference(metadataNumber, 0))
+
+	if o.Lang != "" {
+		catalog.set("/Lang", encodeText(o.Lang))
+	}
+	// viewers show the title instead of the file name
+	catalog.set("/ViewerPreferences", "<</DisplayDocTitle true>>")
+
+	if o.Profile == models.PDFProfileA2B {
+		profileNumber := u.newObject()
+		u.writeStream(profileNumber, 0, dict{{key: "/N", value: "3"}}, srgbProfile)
+		catalog.set("/OutputIntents", fmt.Sprintf("[<</Type /OutputIntent\n/S /GTS_PDFA1\n/OutputConditionIdentifier %s\n/Info %s\n/DestOutputProfile %s>>]",
+			encodeText(srgbProfileName), encodeText(srgbProfileName), reference(profileNumber, 0)))
+	}
+	u.write(catalogNumber, catalogGeneration, catalog.String())
+
+	trailer := append(dict{}, f.trailer...)
+	trailer.set("/Info", reference(infoNumber, infoGeneration))
+	if trailer.get("/ID") == "" {
+		// PDF/A asks for a file identifier, Chrome writes none
+		sum := md5.Sum(data)
+		trailer.set("/ID", fmt.Sprintf("[<%X> <%X>]", sum, sum))
+	}
+
+	return u.bytes(trailer), nil
+}
+
+// xmp writes the XMP metadata packet, the PDF/A identification is only declared for
+// the PDF/A profile.
+func (o DocumentOptions) xmp(creator, producer string) []byte {
+	var b bytes.Buffer
+	text := func(s string) string {
+		var escaped bytes.Buffer
+		xml.EscapeText(&escaped, []byte(s))
+		return escaped.String()
+	}
+	date := o.CreatedAt.UTC().Format(xmpDateLayout)
+
+	b.WriteString("<?xpacket begin=\"\ufeff\" id=\"W5M0MpCehiHzreSzNTczkc9d\"?>\n")
+	b.WriteString(`<x:xmpmeta xmlns:x="adobe:ns:meta/">` + "\n")
+	b.WriteString(`<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">` + "\n")
+	b.WriteString(`<rdf:Description rdf:about="" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:xmp="http://ns.adobe.com/xap/1.0/" xmlns:pdf="http://ns.adobe.com/pdf/1.3/" xmlns:pdfaid="http://www.aiim.org/pdfa/ns/id/">` + "\n")
+	b.WriteString("<dc:format>application/pdf</dc:format>\n")
+	if o.Title != "" {
+		fmt.Fprintf(&b, "<dc:title><rdf:Alt><rdf:li xml:lang=\"x-default\">%s</rdf:li></rdf:Alt></dc:title>\n", text(o.Title))
+	}
+	if o.Author != "" {
+		fmt.Fprintf(&b, "<dc:creator><rdf:Seq><rdf:li>%s</rdf:li></rdf:Seq></dc:creator>\n", text(o.Author))
+	}
+	if o.Subject != "" {
+		fmt.Fprintf(&b, "<dc:description><rdf:Alt><rdf:li xml:lang=\"x-default\">%s</rdf:li></rdf:Alt></dc:description>\n", text(o.Subject))
+	}
+	if o.Lang != "" {
+		fmt.Fprintf(&b, "<dc:language><rdf:Bag><rdf:li>%s</rdf:li></rdf:Bag></dc:language>\n", text(o.Lang))
+	}
+	if len(o.Keywords) > 0 {
+		fmt.Fprintf(&b, "<pdf:Keywords>%s</pdf:Keywords>\n", text(strings.Join(o.Keywords, ", ")))
+	}
+	if producer != "" {
+		fmt.Fprintf(&b, "<pdf:Producer>%s</pdf:Producer>\n", text(producer))
+	}
+	if creator != "" {
+		fmt.Fprintf(&b, "<xmp:CreatorTool>%s</xmp:CreatorTool>\n", text(creator))
+	}
+	fmt.Fprintf(&b, "<xmp:CreateDate>%s</xmp:CreateDate>\n<xmp:ModifyDate>%s</xmp:ModifyDate>\n<xmp:MetadataDate>%s</xmp:MetadataDate>\n", date, date, date)
+	if o.Profile == models.PDFProfileA2B {
+		b.WriteString("<pdfaid:part>2</pdfaid:part>\n<pdfaid:conformance>B</pdfaid:conformance>\n")
+	}
+	b.WriteString("</rdf:Description>\n</rdf:RDF>\n</x:xmpmeta>\n")
+	b.WriteString(`<?xpacket end="w"?>`)
+
+	return b.Bytes()
+}
@@ -0,0 +1,215 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+// classicPDF writes objects numbered from 1 with a classic cross-reference table, the
+// way Chrome prints a document.
+func classicPDF(trailer string, objects ...string) []byte {
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&b, "trailer\n%s\nstartxref\n%d\n%%%%EOF\n", trailer, xref)
+
+	return b.Bytes()
+}
+
+// withOffset rewrites the cross-reference entry of object number written by classicPDF.
+func withOffset(data []byte, number int, offset string) []byte {
+	xref := bytes.LastIndex(data, []byte("\nxref\n")) + 1
+	entry := bytes.IndexByte(data[xref+len("xref\n"):], '\n') + xref + len("xref\n") + 1 + 20*number
+
+	out := append([]byte{}, data...)
+	copy(out[entry:entry+10], fmt.Sprintf("%10s", offset))
+	return out
+}
+
+// withStartxref points the document to another cross-reference section.
+func withStartxref(data []byte, startxref string) []byte {
+	out := append([]byte{}, data[:bytes.LastIndex(data, []byte("startxref"))]...)
+	return append(out, "startxref\n"+startxref+"\n%%EOF\n"...)
+}
+
+var chromePDF = classicPDF("<</Size 5\n/Root 1 0 R\n/Info 4 0 R>>",
+	"<</Type /Catalog\n/Pages 2 0 R>>",
+	"<</Type /Pages\n/Kids [3 0 R]\n/Count 1>>",
+	"<</Type /Page\n/Parent 2 0 R\n/MediaBox [0 0 612 792]>>",
+	"<</Creator (Chromium)\n/Producer (Skia/PDF m126)\n/CreationDate (D:20240615120000+00'00')>>",
+)
+
+// stream returns the content of the stream object referenced by value.
+func stream(t *testing.T, f *file, value string) string {
+	t.Helper()
+
+	number, _, ok := parseReference(value)
+	if !ok {
+		t.Fatalf("%q is not a reference", value)
+	}
+	start := f.objects[number].offset
+	content := f.data[start:]
+	begin := bytes.Index(content, []byte("stream\n"))
+	end := bytes.Index(content, []byte("\nendstream"))
+	if begin < 0 || end < begin {
+		t.Fatalf("object %d is not a stream", number)
+	}
+	return string(content[begin+len("stream\n") : end])
+}
+
+func TestApply(t *testing.T) {
+	createdAt := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	for _, profile := range []string{models.PDFProfileDefault, models.PDFProfileA2B} {
+		t.Run("profile "+profile, func(t *testing.T) {
+			options := DocumentOptions{
+				Title:     "Jürgen Doe - Engineer",
+				Author:    "Jürgen Doe",
+				Subject:   "Engineer",
+				Keywords:  []string{"Go", "Rust"},
+				Lang:      "de",
+				Profile:   profile,
+				CreatedAt: createdAt,
+			}
+
+			out, err := options.apply(chromePDF)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.HasPrefix(out, chromePDF) {
+				t.Fatal("the printed document is not kept in front of the update")
+			}
+
+			f, err := readFile(out)
+			if err != nil {
+				t.Fatalf("the updated PDF cannot be read back: %v", err)
+			}
+			if prev := f.trailer.get("/Prev"); prev == "" {
+				t.Error("the update is not linked to the printed cross-reference table")
+			}
+			if f.trailer.get("/ID") == "" {
+				t.Error("the trailer has no file identifier")
+			}
+
+			infoNumber, _, _ := parseReference(f.trailer.get("/Info"))
+			info, _, err := f.object(infoNumber)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for key, want := range map[string]string{
+				"/Title":        options.Title,
+				"/Author":       options.Author,
+				"/Subject":      options.Subject,
+				"/Keywords":     "Go, Rust",
+				"/Creator":      "Chromium",
+				"/Producer":     "Skia/PDF m126",
+				"/CreationDate": "D:20240615120000Z",
+				"/ModDate":      "D:20240615120000Z",
+			} {
+				if got, _ := decodeText(info.get(key)); got != want {
+					t.Errorf("info %s = %q, want %q", key, got, want)
+				}
+			}
+
+			catalogNumber, _, _ := parseReference(f.trailer.get("/Root"))
+			catalog, _, err := f.object(catalogNumber)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := catalog.get("/Pages"); got != "2 0 R" {
+				t.Errorf("catalog /Pages = %q, the printed pages are lost", got)
+			}
+			if got, _ := decodeText(catalog.get("/Lang")); got != "de" {
+				t.Errorf("catalog /Lang = %q, want de", got)
+			}
+
+			xmp := stream(t, f, catalog.get("/Metadata"))
+			for _, want := range []string{
+				`<rdf:li xml:lang="x-default">Jürgen Doe - Engineer</rdf:li>`,
+				"<rdf:li>Jürgen Doe</rdf:li>",
+				"<pdf:Keywords>Go, Rust</pdf:Keywords>",
+				"<pdf:Producer>Skia/PDF m126</pdf:Producer>",
+				"<xmp:CreatorTool>Chromium</xmp:CreatorTool>",
+				"<xmp:CreateDate>2024-06-15T12:00:00Z</xmp:CreateDate>",
+			} {
+				if !strings.Contains(xmp, want) {
+					t.Errorf("XMP metadata misses %s", want)
+				}
+			}
+
+			pdfa := profile == models.PDFProfileA2B
+			if got := strings.Contains(xmp, "<pdfaid:part>2</pdfaid:part>"); got != pdfa {
+				t.Errorf("XMP declares PDF/A-2 = %v, want %v", got, pdfa)
+			}
+			intents := catalog.get("/OutputIntents")
+			if (intents != "") != pdfa {
+				t.Fatalf("catalog /OutputIntents = %q for profile %q", intents, profile)
+			}
+			if pdfa {
+				start := strings.Index(intents, "/DestOutputProfile ") + len("/DestOutputProfile ")
+				end := strings.Index(intents[start:], "R") + start + 1
+				if got := stream(t, f, intents[start:end]); got != string(srgbProfile) {
+					t.Error("the output intent does not embed the sRGB profile")
+				}
+			}
+		})
+	}
+}
+
+func TestApplyMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty"},
+		{name: "no startxref", data: bytes.Replace(chromePDF, []byte("startxref"), []byte("startxxx"), 1)},
+		{name: "startxref past the end", data: withStartxref(chromePDF, "99999")},
+		{name: "startxref negative", data: withStartxref(chromePDF, "-5")},
+		{name: "object offset past the end", data: withOffset(chromePDF, 1, "9999999999")},
+		{name: "object offset negative", data: withOffset(chromePDF, 4, "-1")},
+		{name: "object offset elsewhere", data: withOffset(chromePDF, 1, "3")},
+		{name: "cross-reference stream", data: withStartxref(chromePDF, fmt.Sprint(bytes.Index(chromePDF, []byte("1 0 obj"))))},
+		{name: "unterminated trailer", data: bytes.Replace(chromePDF, []byte("/Info 4 0 R>>"), []byte("/Info 4 0 R"), 1)},
+		{name: "unterminated catalog", data: bytes.Replace(chromePDF, []byte("/Pages 2 0 R>>"), []byte("/Pages [2 0 R"), 1)},
+		{name: "no catalog", data: bytes.Replace(chromePDF, []byte("/Root 1 0 R"), []byte("/Rot 1 0 R"), 1)},
+		{name: "encrypted", data: bytes.Replace(chromePDF, []byte("/Info 4 0 R>>"), []byte("/Info 4 0 R\n/Encrypt 5 0 R>>"), 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := (DocumentOptions{Title: "John Doe"}).apply(tt.data); err == nil {
+				t.Error("apply() succeeded on a malformed PDF")
+			}
+		})
+	}
+}
+
+// TestApplyTruncated cuts the document at every byte, none of the prefixes may panic.
+func TestApplyTruncated(t *testing.T) {
+	for n := range chromePDF {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("apply() panicked on the first %d bytes: %v", n, r)
+				}
+			}()
+			_, _ = (DocumentOptions{Title: "John Doe"}).apply(chromePDF[:n])
+		}()
+	}
+}
@@ -0,0 +1,76 @@
+package pdf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/chromedp/chromedp"
+	"github.com/pkg/errors"
+)
+
+const maxWatermarkLen = 40
+
+// watermarkPresets are the watermarks asked for by name.
+var watermarkPresets = map[string]string{
+	"draft":        "DRAFT",
+	"confidential": "Confidential",
+}
+
+// watermarkScript lays the watermark across the page. A fixed element is printed on
+// every page, its size shrinks for long texts to stay within the diagonal.
+const watermarkScript = `(text => {
+	const mark = document.createElement('div');
+	mark.textContent = text;
+	mark.setAttribute('aria-hidden', 'true');
+	mark.style.cssText = 'position: fixed; top: 50%%; left: 50%%; transform: translate(-50%%, -50%%) rotate(-45deg);' +
+		'font-size: ' + Math.min(120, 1400 / Math.max(text.length, 1)) + 'px; font-weight: bold; white-space: nowrap;' +
+		'color: rgba(200, 0, 0, 0.15); pointer-events: none; z-index: 2147483647;';
+	document.body.appendChild(mark);
+})(%s)`
+
+// ParseWatermark returns the text printed across a draft, the preset named by watermark
+// or the text itself. It is empty for the final document.
+func ParseWatermark(watermark string) (string, error) {
+	watermark = strings.TrimSpace(watermark)
+	if preset, ok := watermarkPresets[strings.ToLower(watermark)]; ok {
+		return preset, nil
+	}
+
+	if utf8.RuneCountInString(watermark) > maxWatermarkLen {
+		return "", errors.New(fmt.Sprintf("watermark must not exceed %d characters", maxWatermarkLen))
+	}
+	if strings.IndexFunc(watermark, unicode.IsControl) >= 0 {
+		return "", errors.New("watermark must be a single line of text")
+	}
+
+	return watermark, nil
+}
+
+// WithDraft prints the options of a review copy: watermark crosses every page and stamp,
+// the localized time it was generated, is printed in the footer. The options are made
+// from a layout marked as Draft, its margins keep room for the stamp.
+func (o PageOptions) WithDraft(watermark, stamp string) PageOptions {
+	o.Watermark = watermark
+
+	stamp = headerFooterTemplate(stamp, "", "")
+	if o.HeaderTemplate == "" {
+		o.HeaderTemplate = headerFooterTemplate("", "", "")
+	}
+	o.FooterTemplate += stamp
+
+	return o
+}
+
+// printWatermark adds the watermark of opts to the loaded document, if any.
+func printWatermark(opts PageOptions) chromedp.Action {
+	if opts.Watermark == "" {
+		return chromedp.Tasks{}
+	}
+
+	// the text is passed as a JSON string, the document never parses it
+	text, _ := json.Marshal(opts.Watermark)
+	return chromedp.Evaluate(fmt.Sprintf(watermarkScript, text), nil)
+}
@@ -0,0 +1,66 @@
+package pdf
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+func TestParseWatermark(t *testing.T) {
+	tests := []struct {
+		watermark string
+		want      string
+		wantErr   bool
+	}{
+		{watermark: "", want: ""},
+		{watermark: "draft", want: "DRAFT"},
+		{watermark: " Confidential ", want: "Confidential"},
+		{watermark: "For review", want: "For review"},
+		{watermark: strings.Repeat("ж", maxWatermarkLen), want: strings.Repeat("ж", maxWatermarkLen)},
+		{watermark: strings.Repeat("ж", maxWatermarkLen+1), wantErr: true},
+		{watermark: "two\nlines", wantErr: true},
+		{watermark: "tab\there", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseWatermark(tt.watermark)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseWatermark(%q) error = %v, wantErr %v", tt.watermark, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseWatermark(%q) = %q, want %q", tt.watermark, got, tt.want)
+		}
+	}
+}
+
+func TestWithDraft(t *testing.T) {
+	now := time.Date(2026, time.March, 4, 10, 0, 0, 0, time.UTC)
+
+	opts, err := NewPageOptions(models.PageLayout{Draft: true, Footer: "{page}"}, "John Doe", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts = opts.WithDraft("DRAFT", "Generated <today>")
+
+	if opts.Watermark != "DRAFT" {
+		t.Errorf("Watermark = %q, want %q", opts.Watermark, "DRAFT")
+	}
+	if opts.MarginBottom < models.MinHeaderMargin {
+		t.Errorf("MarginBottom = %v, leaves no room for the stamp", opts.MarginBottom)
+	}
+	for _, s := range []string{`<span class="pageNumber"></span>`, "Generated &lt;today&gt;"} {
+		if !strings.Contains(opts.FooterTemplate, s) {
+			t.Errorf("FooterTemplate = %q, misses %q", opts.FooterTemplate, s)
+		}
+	}
+	// Chrome prints its own header when only the footer is given
+	if opts.HeaderTemplate == "" {
+		t.Error("HeaderTemplate is empty")
+	}
+	if !opts.DisplayHeaderFooter() {
+		t.Error("DisplayHeaderFooter() = false for a draft")
+	}
+}
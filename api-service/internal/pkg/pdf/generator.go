@@ -5,63 +5,123 @@ import (
 	"fmt"
 	"github.com/chromedp/cdproto/emulation"
 	"github.com/chromedp/cdproto/page"
+	"github.com/chromedp/cdproto/runtime"
 	"github.com/chromedp/chromedp"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/logger"
 	"github.com/pkg/errors"
-	"os"
 	"time"
 )
 
 const (
 	userAgentOverride = "WebScraper 1.0"
 	htmlSelector      = "body"
+	blankPage         = "about:blank"
+	cssPixelsPerInch  = 96
 )
 
+// pageReadyScript resolves once images and web fonts of the injected document are loaded.
+const pageReadyScript = `new Promise(resolve => {
+	if (document.readyState === 'complete') {
+		resolve();
+	} else {
+		window.addEventListener('load', () => resolve());
+	}
+}).then(() => document.fonts.ready).then(() => true)`
+
 // Generator provides functionality to generate PDF from HTML.
-type Generator struct{}
+type Generator struct {
+	pool *Pool
+}
 
-// NewPDFGenerator creates a new instance of PDFGenerator.
-func NewPDFGenerator() *Generator {
-	return &Generator{}
+// NewPDFGenerator creates a new instance of PDFGenerator rendering on the given pool.
+func NewPDFGenerator(pool *Pool) *Generator {
+	return &Generator{
+		pool: pool,
+	}
 }
 
-// GenerateFromHTML generates a PDF from HTML file.
-func (g *Generator) GenerateFromHTML(file string) ([]byte, error) {
+// GenerateFromHTML generates a PDF from an in-memory HTML document printed with opts
+// and described by document. It waits for a free tab when every tab of the pool is busy.
+func (g *Generator) GenerateFromHTML(ctx context.Context, html []byte, opts PageOptions, document DocumentOptions) ([]byte, error) {
 	startedAt := time.Now()
 
-	chromeCtx, cancelCtx := chromedp.NewContext(context.Background())
-	defer cancelCtx()
-
 	var pdfData []byte
-	url := getFilePathAsURL(file)
 
-	if err := chromedp.Run(chromeCtx, g.saveURLAsPDF(url, &pdfData)); err != nil {
-		return nil, errors.Wrap(err, "GenerateFromHTML - chromedp.Run")
+	if err := g.pool.Run(ctx, g.saveHTMLAsPDF(html, opts, document.Tagged, &pdfData)); err != nil {
+		return nil, errors.Wrap(err, "GenerateFromHTML - pool.Run")
 	}
 
-	logger.Error(errors.New(fmt.Sprintf("PDF %s generated in %f seconds", file, time.Since(startedAt).Seconds())))
+	pdfData, err := document.apply(pdfData)
+	if err != nil {
+		return nil, errors.Wrap(err, "GenerateFromHTML - DocumentOptions.apply")
+	}
+
+	logger.Error(errors.New(fmt.Sprintf("PDF of %d bytes generated in %f seconds", len(pdfData), time.Since(startedAt).Seconds())))
 
 	return pdfData, nil
 }
 
-func (g *Generator) saveURLAsPDF(url string, pdf *[]byte) chromedp.Tasks {
+// ScreenshotFromHTML renders the first page of an in-memory HTML document as a PNG image.
+func (g *Generator) ScreenshotFromHTML(ctx context.Context, html []byte, opts PageOptions) ([]byte, error) {
+	startedAt := time.Now()
+
+	var pngData []byte
+
+	if err := g.pool.Run(ctx, g.captureFirstPage(html, opts, &pngData)); err != nil {
+		return nil, errors.Wrap(err, "ScreenshotFromHTML - pool.Run")
+	}
+
+	logger.Error(errors.New(fmt.Sprintf("PNG of %d bytes generated in %f seconds", len(pngData), time.Since(startedAt).Seconds())))
+
+	return pngData, nil
+}
+
+// loadHTML replaces the document of the tab with html and waits until it is fully loaded.
+func loadHTML(html []byte) chromedp.Tasks {
+	var ready bool
+
 	return chromedp.Tasks{
 		emulation.SetUserAgentOverride(userAgentOverride),
-		chromedp.Navigate(url),
+		chromedp.Navigate(blankPage),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			frameTree, err := page.GetFrameTree().Do(ctx)
+			if err != nil {
+				return errors.Wrap(err, "saveHTMLAsPDF - page.GetFrameTree")
+			}
+			if err := page.SetDocumentContent(frameTree.Frame.ID, string(html)).Do(ctx); err != nil {
+				return errors.Wrap(err, "saveHTMLAsPDF - page.SetDocumentContent")
+			}
+			return nil
+		}),
 		chromedp.WaitVisible(htmlSelector, chromedp.ByQuery),
+		chromedp.Evaluate(pageReadyScript, &ready, func(p *runtime.EvaluateParams) *runtime.EvaluateParams {
+			return p.WithAwaitPromise(true)
+		}),
+	}
+}
+
+func (g *Generator) saveHTMLAsPDF(html []byte, opts PageOptions, tagged bool, pdf *[]byte) chromedp.Tasks {
+	return chromedp.Tasks{
+		loadHTML(html),
+		printWatermark(opts),
 		chromedp.ActionFunc(func(ctx context.Context) error {
 			data, _, err := page.
 				PrintToPDF().
-				WithMarginLeft(0).
-				WithMarginTop(0).
-				WithMarginRight(0).
-				WithMarginBottom(0).
-				WithPaperWidth(8.3).
-				WithPaperHeight(11.7).
+				WithMarginLeft(opts.MarginLeft).
+				WithMarginTop(opts.MarginTop).
+				WithMarginRight(opts.MarginRight).
+				WithMarginBottom(opts.MarginBottom).
+				WithPaperWidth(opts.PaperWidth).
+				WithPaperHeight(opts.PaperHeight).
+				WithLandscape(opts.Landscape).
+				WithDisplayHeaderFooter(opts.DisplayHeaderFooter()).
+				WithHeaderTemplate(opts.HeaderTemplate).
+				WithFooterTemplate(opts.FooterTemplate).
 				WithPrintBackground(true).
+				WithGenerateTaggedPDF(tagged).
 				Do(ctx)
 			if err != nil {
-				return errors.Wrap(err, "saveURLAsPDF - page.PrintToPDF")
+				return errors.Wrap(err, "saveHTMLAsPDF - page.PrintToPDF")
 			}
 			*pdf = data
 			return nil
@@ -69,11 +129,31 @@ func (g *Generator) saveURLAsPDF(url string, pdf *[]byte) chromedp.Tasks {
 	}
 }
 
-func getFilePathAsURL(filename string) string {
-	path, err := os.Getwd()
-	if err != nil {
-		logger.Error(err)
+// captureFirstPage lays the document out at the paper width with print styles and
+// captures the height of one sheet. Tabs are shared, so the emulation is reset afterwards.
+func (g *Generator) captureFirstPage(html []byte, opts PageOptions, png *[]byte) chromedp.Tasks {
+	width, height := opts.PaperWidth, opts.PaperHeight
+	if opts.Landscape {
+		width, height = height, width
 	}
+	widthPx, heightPx := int64(width*cssPixelsPerInch), int64(height*cssPixelsPerInch)
 
-	return fmt.Sprintf("file://%s/%s", path, filename)
+	return chromedp.Tasks{
+		emulation.SetEmulatedMedia().WithMedia("print"),
+		emulation.SetDeviceMetricsOverride(widthPx, heightPx, 1, false),
+		loadHTML(html),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			data, err := page.CaptureScreenshot().
+				WithFormat(page.CaptureScreenshotFormatPng).
+				WithClip(&page.Viewport{Width: float64(widthPx), Height: float64(heightPx), Scale: 1}).
+				Do(ctx)
+			if err != nil {
+				return errors.Wrap(err, "captureFirstPage - page.CaptureScreenshot")
+			}
+			*png = data
+			return nil
+		}),
+		emulation.ClearDeviceMetricsOverride(),
+		emulation.SetEmulatedMedia(),
+	}
 }
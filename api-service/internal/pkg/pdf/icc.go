@@ -0,0 +1,118 @@
+package pdf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+)
+
+// srgbProfileName identifies the sRGB color space in output intents.
+const srgbProfileName = "sRGB IEC61966-2.1"
+
+// srgbProfile is the output intent of PDF/A documents, Chrome paints in sRGB.
+var srgbProfile = newSRGBProfile()
+
+// iccTag is a tag of an ICC profile with its data.
+type iccTag struct {
+	signature string
+	data      []byte
+}
+
+// newSRGBProfile builds an ICC version 2 display profile of the sRGB color space with
+// the primaries adapted to D50 and the transfer function sampled in a table.
+func newSRGBProfile() []byte {
+	const samples = 1024
+	curve := make([]uint16, samples)
+	for i := range curve {
+		v := float64(i) / (samples - 1)
+		if v <= 0.04045 {
+			v /= 12.92
+		} else {
+			v = math.Pow((v+0.055)/1.055, 2.4)
+		}
+		curve[i] = uint16(math.Round(v * 0xffff))
+	}
+	trc := iccCurve(curve)
+
+	tags := []iccTag{
+		{signature: "desc", data: iccTextDescription(srgbProfileName)},
+		{signature: "cprt", data: iccText("No copyright, use freely")},
+		{signature: "wtpt", data: iccXYZ(0.9505, 1.0, 1.0891)},
+		{signature: "rXYZ", data: iccXYZ(0.4361, 0.2225, 0.0139)},
+		{signature: "gXYZ", data: iccXYZ(0.3851, 0.7169, 0.0971)},
+		{signature: "bXYZ", data: iccXYZ(0.1431, 0.0606, 0.7141)},
+		{signature: "rTRC", data: trc},
+		{signature: "gTRC", data: trc},
+		{signature: "bTRC", data: trc},
+	}
+
+	// tag data follows the header and the tag table, identical data is stored once
+	var table, data bytes.Buffer
+	offsets := make(map[string]int)
+	start := 128 + 4 + 12*len(tags)
+	binary.Write(&table, binary.BigEndian, uint32(len(tags)))
+	for _, tag := range tags {
+		offset, ok := offsets[string(tag.data)]
+		if !ok {
+			offset = start + data.Len()
+			offsets[string(tag.data)] = offset
+			data.Write(tag.data)
+			for data.Len()%4 != 0 {
+				data.WriteByte(0)
+			}
+		}
+		table.WriteString(tag.signature)
+		binary.Write(&table, binary.BigEndian, []uint32{uint32(offset), uint32(len(tag.data))})
+	}
+
+	header := make([]byte, 128)
+	binary.BigEndian.PutUint32(header[0:], uint32(start+data.Len()))
+	binary.BigEndian.PutUint32(header[8:], 0x02100000)
+	copy(header[12:], "mntr")
+	copy(header[16:], "RGB ")
+	copy(header[20:], "XYZ ")
+	for i, v := range []uint16{2024, 1, 1, 0, 0, 0} {
+		binary.BigEndian.PutUint16(header[24+2*i:], v)
+	}
+	copy(header[36:], "acsp")
+	// the illuminant of the profile connection space is D50
+	copy(header[68:], iccXYZ(0.9642, 1.0, 0.8249)[8:])
+
+	profile := append(header, table.Bytes()...)
+	return append(profile, data.Bytes()...)
+}
+
+func iccS15Fixed16(v float64) uint32 {
+	return uint32(int32(math.Round(v * 0x10000)))
+}
+
+func iccXYZ(x, y, z float64) []byte {
+	var b bytes.Buffer
+	b.WriteString("XYZ \x00\x00\x00\x00")
+	binary.Write(&b, binary.BigEndian, []uint32{iccS15Fixed16(x), iccS15Fixed16(y), iccS15Fixed16(z)})
+	return b.Bytes()
+}
+
+func iccCurve(curve []uint16) []byte {
+	var b bytes.Buffer
+	b.WriteString("curv\x00\x00\x00\x00")
+	binary.Write(&b, binary.BigEndian, uint32(len(curve)))
+	binary.Write(&b, binary.BigEndian, curve)
+	return b.Bytes()
+}
+
+func iccText(text string) []byte {
+	return []byte("text\x00\x00\x00\x00" + text + "\x00")
+}
+
+// iccTextDescription writes the ASCII description, the Unicode and ScriptCode
+// descriptions are left empty.
+func iccTextDescription(text string) []byte {
+	var b bytes.Buffer
+	b.WriteString("desc\x00\x00\x00\x00")
+	binary.Write(&b, binary.BigEndian, uint32(len(text)+1))
+	b.WriteString(text + "\x00")
+	binary.Write(&b, binary.BigEndian, []uint32{0, 0})
+	b.Write(make([]byte, 2+1+67))
+	return b.Bytes()
+}
@@ -0,0 +1,101 @@
+package pdf
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxMargin          = 2.0
+	maxHeaderFooterLen = 200
+	headerFooterDate   = "2 January 2006"
+)
+
+// PageOptions are the print settings handed to Chrome.
+type PageOptions struct {
+	PaperWidth     float64
+	PaperHeight    float64
+	Landscape      bool
+	MarginTop      float64
+	MarginRight    float64
+	MarginBottom   float64
+	MarginLeft     float64
+	HeaderTemplate string
+	FooterTemplate string
+	// Watermark is printed across every page of a draft
+	Watermark string
+}
+
+// DisplayHeaderFooter reports whether a header or a footer has to be printed.
+func (o PageOptions) DisplayHeaderFooter() bool {
+	return o.HeaderTemplate != "" || o.FooterTemplate != ""
+}
+
+// NewPageOptions validates the layout requested in resume meta and converts it to print options.
+func NewPageOptions(layout models.PageLayout, name string, now time.Time) (PageOptions, error) {
+	layout = layout.WithDefaults()
+
+	// Chrome rotates the paper itself for landscape, so it is given portrait dimensions
+	width, height, ok := models.PageLayout{Size: layout.Size}.Dimensions()
+	if !ok {
+		return PageOptions{}, errors.New(fmt.Sprintf("unknown paper size %q", layout.Size))
+	}
+
+	opts := PageOptions{
+		PaperWidth:  width,
+		PaperHeight: height,
+	}
+
+	switch layout.Orientation {
+	case models.OrientationPortrait:
+	case models.OrientationLandscape:
+		opts.Landscape = true
+	default:
+		return PageOptions{}, errors.New(fmt.Sprintf("unknown orientation %q", layout.Orientation))
+	}
+
+	for _, margin := range []float64{layout.Margins.Top, layout.Margins.Right, layout.Margins.Bottom, layout.Margins.Left} {
+		if margin < 0 || margin > maxMargin {
+			return PageOptions{}, errors.New(fmt.Sprintf("margins must be between 0 and %.0f inches", maxMargin))
+		}
+	}
+
+	// Chrome draws header and footer inside the margins, templates size their pages with the same margins
+	margins := layout.PrintMargins()
+	opts.MarginTop, opts.MarginRight, opts.MarginBottom, opts.MarginLeft = margins.Top, margins.Right, margins.Bottom, margins.Left
+
+	if len(layout.Header) > maxHeaderFooterLen || len(layout.Footer) > maxHeaderFooterLen {
+		return PageOptions{}, errors.New(fmt.Sprintf("header and footer must not exceed %d characters", maxHeaderFooterLen))
+	}
+
+	if layout.Header == "" && layout.Footer == "" {
+		return opts, nil
+	}
+
+	date := now.Format(headerFooterDate)
+	opts.HeaderTemplate = headerFooterTemplate(layout.Header, name, date)
+	opts.FooterTemplate = headerFooterTemplate(layout.Footer, name, date)
+
+	return opts, nil
+}
+
+// headerFooterTemplate escapes user text and swaps placeholders for Chrome's print classes.
+// An empty text still yields an element, otherwise Chrome prints its default title and URL.
+func headerFooterTemplate(text, name, date string) string {
+	replacer := strings.NewReplacer(
+		"{page}", `<span class="pageNumber"></span>`,
+		"{pages}", `<span class="totalPages"></span>`,
+		"{name}", html.EscapeString(name),
+		"{date}", html.EscapeString(date),
+	)
+
+	return fmt.Sprintf(
+		`<div style="width:100%%;padding:0 0.4in;font-size:9px;color:#555;text-align:center;">%s</div>`,
+		replacer.Replace(html.EscapeString(text)),
+	)
+}
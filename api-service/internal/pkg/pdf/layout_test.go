@@ -0,0 +1,100 @@
+package pdf
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+func TestNewPageOptions(t *testing.T) {
+	now := time.Date(2026, time.March, 4, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		layout  models.PageLayout
+		want    PageOptions
+		wantErr bool
+	}{
+		{name: "defaults", want: PageOptions{PaperWidth: 8.27, PaperHeight: 11.69}},
+		{
+			name:   "landscape keeps portrait dimensions",
+			layout: models.PageLayout{Size: models.PaperLetter, Orientation: models.OrientationLandscape},
+			want:   PageOptions{PaperWidth: 8.5, PaperHeight: 11, Landscape: true},
+		},
+		{
+			name:   "margins",
+			layout: models.PageLayout{Margins: models.PageMargins{Top: 0.5, Right: 1, Bottom: 0.5, Left: 1}},
+			want:   PageOptions{PaperWidth: 8.27, PaperHeight: 11.69, MarginTop: 0.5, MarginRight: 1, MarginBottom: 0.5, MarginLeft: 1},
+		},
+		{
+			name:   "header raises the top margin",
+			layout: models.PageLayout{Header: "{name}"},
+			want:   PageOptions{PaperWidth: 8.27, PaperHeight: 11.69, MarginTop: models.MinHeaderMargin},
+		},
+		{
+			name:   "footer raises the bottom margin",
+			layout: models.PageLayout{Footer: "{page}", Margins: models.PageMargins{Top: 0.2, Bottom: 0.2}},
+			want:   PageOptions{PaperWidth: 8.27, PaperHeight: 11.69, MarginTop: 0.2, MarginBottom: models.MinHeaderMargin},
+		},
+		{
+			name:   "wide margins are kept",
+			layout: models.PageLayout{Header: "{name}", Margins: models.PageMargins{Top: 1}},
+			want:   PageOptions{PaperWidth: 8.27, PaperHeight: 11.69, MarginTop: 1},
+		},
+		{
+			name:   "draft raises the bottom margin",
+			layout: models.PageLayout{Draft: true},
+			want:   PageOptions{PaperWidth: 8.27, PaperHeight: 11.69, MarginBottom: models.MinHeaderMargin},
+		},
+		{name: "unknown size", layout: models.PageLayout{Size: "A3"}, wantErr: true},
+		{name: "unknown orientation", layout: models.PageLayout{Orientation: "diagonal"}, wantErr: true},
+		{name: "negative margin", layout: models.PageLayout{Margins: models.PageMargins{Left: -0.1}}, wantErr: true},
+		{name: "margin too wide", layout: models.PageLayout{Margins: models.PageMargins{Top: maxMargin + 0.1}}, wantErr: true},
+		{name: "header too long", layout: models.PageLayout{Header: strings.Repeat("a", maxHeaderFooterLen+1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPageOptions(tt.layout, "John Doe", now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPageOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// header and footer are checked on their own
+			got.HeaderTemplate, got.FooterTemplate = "", ""
+			if got != tt.want {
+				t.Errorf("NewPageOptions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPageOptionsHeaderFooter(t *testing.T) {
+	now := time.Date(2026, time.March, 4, 10, 0, 0, 0, time.UTC)
+
+	opts, err := NewPageOptions(models.PageLayout{Header: "{name} <CV>", Footer: "{page}/{pages} {date}"}, "John & Jane", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !opts.DisplayHeaderFooter() {
+		t.Error("DisplayHeaderFooter() = false with a header and a footer")
+	}
+	if s := "John &amp; Jane &lt;CV&gt;"; !strings.Contains(opts.HeaderTemplate, s) {
+		t.Errorf("HeaderTemplate = %q, misses %q", opts.HeaderTemplate, s)
+	}
+	for _, s := range []string{`<span class="pageNumber"></span>/<span class="totalPages"></span>`, "4 March 2026"} {
+		if !strings.Contains(opts.FooterTemplate, s) {
+			t.Errorf("FooterTemplate = %q, misses %q", opts.FooterTemplate, s)
+		}
+	}
+
+	// without header nor footer Chrome must not print its own
+	opts, err = NewPageOptions(models.PageLayout{}, "John Doe", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.DisplayHeaderFooter() {
+		t.Error("DisplayHeaderFooter() = true without header and footer")
+	}
+}
@@ -0,0 +1,536 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/pkg/errors"
+)
+
+// Reading and updating the objects of a PDF as Chrome writes it: a classic
+// cross-reference table, no cross-reference stream and no encryption. Values are kept
+// as they are written, only the dictionaries holding them are rewritten.
+
+// dictEntry is a key of a dictionary with its value as written in the file.
+type dictEntry struct {
+	key   string
+	value string
+}
+
+// dict is a dictionary keeping the order of its keys.
+type dict []dictEntry
+
+func (d dict) get(key string) string {
+	for _, entry := range d {
+		if entry.key == key {
+			return entry.value
+		}
+	}
+	return ""
+}
+
+func (d *dict) set(key, value string) {
+	for i := range *d {
+		if (*d)[i].key == key {
+			(*d)[i].value = value
+			return
+		}
+	}
+	*d = append(*d, dictEntry{key: key, value: value})
+}
+
+func (d *dict) delete(key string) {
+	entries := (*d)[:0]
+	for _, entry := range *d {
+		if entry.key != key {
+			entries = append(entries, entry)
+		}
+	}
+	*d = entries
+}
+
+func (d dict) String() string {
+	entries := make([]string, 0, len(d))
+	for _, entry := range d {
+		entries = append(entries, entry.key+" "+entry.value)
+	}
+	return "<<" + strings.Join(entries, "\n") + ">>"
+}
+
+// xrefEntry locates an object in the file.
+type xrefEntry struct {
+	offset     int
+	generation int
+}
+
+// file is a printed PDF, its objects are found through the cross-reference sections.
+type file struct {
+	data []byte
+	// objects holds the newest revision of every object in use
+	objects   map[int]xrefEntry
+	trailer   dict
+	startxref int
+	size      int
+}
+
+func syntaxError(offset int) error {
+	return errors.New(fmt.Sprintf("malformed PDF at offset %d", offset))
+}
+
+func readFile(data []byte) (*file, error) {
+	start := bytes.LastIndex(data, []byte("startxref"))
+	if start < 0 {
+		return nil, errors.New("PDF has no startxref")
+	}
+	startxref, _, err := readInt(data, start+len("startxref"))
+	if err != nil {
+		return nil, err
+	}
+
+	f := &file{data: data, objects: make(map[int]xrefEntry), startxref: startxref}
+	visited := make(map[int]bool)
+	for section := startxref; !visited[section]; {
+		visited[section] = true
+
+		trailer, err := f.readXref(section)
+		if err != nil {
+			return nil, err
+		}
+		if f.trailer == nil {
+			f.trailer = trailer
+		}
+		if trailer.get("/Encrypt") != "" {
+			return nil, errors.New("encrypted PDF cannot be updated")
+		}
+
+		prev := trailer.get("/Prev")
+		if prev == "" {
+			break
+		}
+		if section, err = strconv.Atoi(prev); err != nil {
+			return nil, errors.Wrap(err, "readFile - trailer /Prev")
+		}
+	}
+
+	if f.size, err = strconv.Atoi(f.trailer.get("/Size")); err != nil {
+		return nil, errors.Wrap(err, "readFile - trailer /Size")
+	}
+
+	return f, nil
+}
+
+// readXref records the objects of the cross-reference section at offset unless a newer
+// section did, and returns its trailer.
+func (f *file) readXref(offset int) (dict, error) {
+	if offset < 0 || offset >= len(f.data) || !bytes.HasPrefix(f.data[offset:], []byte("xref")) {
+		return nil, errors.New("PDF cross-reference streams are not supported")
+	}
+
+	i := offset + len("xref")
+	for {
+		i = skipSpace(f.data, i)
+		if bytes.HasPrefix(f.data[i:], []byte("trailer")) {
+			trailer, _, err := parseDict(f.data, skipSpace(f.data, i+len("trailer")))
+			return trailer, err
+		}
+
+		first, next, err := readInt(f.data, i)
+		if err != nil {
+			return nil, err
+		}
+		count, next, err := readInt(f.data, next)
+		if err != nil {
+			return nil, err
+		}
+		i = next
+
+		for number := first; number < first+count; number++ {
+			var entry xrefEntry
+			if entry.offset, i, err = readInt(f.data, i); err != nil {
+				return nil, err
+			}
+			if entry.generation, i, err = readInt(f.data, i); err != nil {
+				return nil, err
+			}
+			i = skipSpace(f.data, i)
+			if i >= len(f.data) {
+				return nil, syntaxError(i)
+			}
+			inUse := f.data[i] == 'n'
+			i++
+			if inUse && (entry.offset < 0 || entry.offset >= len(f.data)) {
+				return nil, syntaxError(i)
+			}
+
+			if _, ok := f.objects[number]; !ok && inUse {
+				f.objects[number] = entry
+			}
+		}
+	}
+}
+
+// object returns the dictionary of an object and its generation.
+func (f *file) object(number int) (dict, int, error) {
+	entry, ok := f.objects[number]
+	if !ok {
+		return nil, 0, errors.New(fmt.Sprintf("PDF object %d not found", number))
+	}
+
+	// readXref checked the offset already, slicing past the data would panic all the same
+	if entry.offset < 0 || entry.offset >= len(f.data) {
+		return nil, 0, syntaxError(entry.offset)
+	}
+
+	i := entry.offset
+	for _, want := range []string{strconv.Itoa(number), strconv.Itoa(entry.generation), "obj"} {
+		i = skipSpace(f.data, i)
+		end := regularEnd(f.data, i)
+		if string(f.data[i:end]) != want {
+			return nil, 0, syntaxError(i)
+		}
+		i = end
+	}
+
+	object, _, err := parseDict(f.data, skipSpace(f.data, i))
+	if err != nil {
+		return nil, 0, err
+	}
+	return object, entry.generation, nil
+}
+
+// update is an incremental update, the objects it writes replace those of the same
+// number and the printed document stays byte for byte in front of it.
+type update struct {
+	file    *file
+	body    bytes.Buffer
+	entries map[int]xrefEntry
+	size    int
+}
+
+func newUpdate(f *file) *update {
+	u := &update{file: f, entries: make(map[int]xrefEntry), size: f.size}
+	if !bytes.HasSuffix(f.data, []byte("\n")) {
+		u.body.WriteByte('\n')
+	}
+	return u
+}
+
+// newObject reserves the number of an object added by the update.
+func (u *update) newObject() int {
+	u.size++
+	return u.size - 1
+}
+
+func (u *update) write(number, generation int, object string) {
+	u.entries[number] = xrefEntry{offset: len(u.file.data) + u.body.Len(), generation: generation}
+	fmt.Fprintf(&u.body, "%d %d obj\n%s\nendobj\n", number, generation, object)
+}
+
+func (u *update) writeStream(number, generation int, d dict, data []byte) {
+	d.set("/Length", strconv.Itoa(len(data)))
+	u.write(number, generation, d.String()+" stream\n"+string(data)+"\nendstream")
+}
+
+// bytes returns the document followed by the update, trailer is completed with the
+// entries linking it to the previous section.
+func (u *update) bytes(trailer dict) []byte {
+	xref := len(u.file.data) + u.body.Len()
+
+	numbers := make([]int, 0, len(u.entries))
+	for number := range u.entries {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+
+	u.body.WriteString("xref\n")
+	for _, number := range numbers {
+		entry := u.entries[number]
+		fmt.Fprintf(&u.body, "%d 1\n%010d %05d n \n", number, entry.offset, entry.generation)
+	}
+
+	trailer.set("/Size", strconv.Itoa(u.size))
+	trailer.set("/Prev", strconv.Itoa(u.file.startxref))
+	fmt.Fprintf(&u.body, "trailer\n%s\nstartxref\n%d\n%%%%EOF\n", trailer, xref)
+
+	out := make([]byte, 0, len(u.file.data)+u.body.Len())
+	out = append(out, u.file.data...)
+	return append(out, u.body.Bytes()...)
+}
+
+// reference formats an indirect reference to an object.
+func reference(number, generation int) string {
+	return fmt.Sprintf("%d %d R", number, generation)
+}
+
+// parseReference reads an indirect reference such as "1 0 R".
+func parseReference(value string) (number, generation int, ok bool) {
+	fields := strings.Fields(value)
+	if len(fields) != 3 || fields[2] != "R" {
+		return 0, 0, false
+	}
+	number, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	generation, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return number, generation, true
+}
+
+func isSpace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+func isDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+func isInteger(token []byte) bool {
+	if len(token) == 0 {
+		return false
+	}
+	for _, c := range token {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// skipSpace returns the offset of the first byte after i that is neither white space
+// nor part of a comment.
+func skipSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch {
+		case isSpace(data[i]):
+			i++
+		case data[i] == '%':
+			for i < len(data) && data[i] != '\n' && data[i] != '\r' {
+				i++
+			}
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// regularEnd returns the end of the regular characters starting at i, the extent of a
+// number, a keyword or a name without its slash.
+func regularEnd(data []byte, i int) int {
+	for i < len(data) && !isSpace(data[i]) && !isDelimiter(data[i]) {
+		i++
+	}
+	return i
+}
+
+func readInt(data []byte, i int) (int, int, error) {
+	i = skipSpace(data, i)
+	end := regularEnd(data, i)
+	value, err := strconv.Atoi(string(data[i:end]))
+	if err != nil {
+		return 0, 0, syntaxError(i)
+	}
+	return value, end, nil
+}
+
+// valueEnd returns the end of the value starting at i.
+func valueEnd(data []byte, i int) (int, error) {
+	if i >= len(data) {
+		return 0, syntaxError(i)
+	}
+
+	switch c := data[i]; {
+	case c == '/':
+		return regularEnd(data, i+1), nil
+	case c == '(':
+		return literalStringEnd(data, i)
+	case c == '<' && i+1 < len(data) && data[i+1] == '<':
+		_, end, err := parseDict(data, i)
+		return end, err
+	case c == '<':
+		end := bytes.IndexByte(data[i:], '>')
+		if end < 0 {
+			return 0, syntaxError(i)
+		}
+		return i + end + 1, nil
+	case c == '[':
+		for i = skipSpace(data, i+1); i < len(data) && data[i] != ']'; i = skipSpace(data, i) {
+			end, err := valueEnd(data, i)
+			if err != nil {
+				return 0, err
+			}
+			i = end
+		}
+		if i >= len(data) {
+			return 0, syntaxError(i)
+		}
+		return i + 1, nil
+	case isDelimiter(c):
+		return 0, syntaxError(i)
+	}
+
+	end := regularEnd(data, i)
+	// an indirect reference is two integers followed by R
+	if isInteger(data[i:end]) {
+		generation := skipSpace(data, end)
+		generationEnd := regularEnd(data, generation)
+		r := skipSpace(data, generationEnd)
+		if isInteger(data[generation:generationEnd]) && r < len(data) && data[r] == 'R' && regularEnd(data, r) == r+1 {
+			return r + 1, nil
+		}
+	}
+	return end, nil
+}
+
+func literalStringEnd(data []byte, i int) (int, error) {
+	depth := 0
+	for j := i; j < len(data); j++ {
+		switch data[j] {
+		case '\\':
+			j++
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return j + 1, nil
+			}
+		}
+	}
+	return 0, syntaxError(i)
+}
+
+// parseDict reads the dictionary starting at i and returns the offset following it.
+func parseDict(data []byte, i int) (dict, int, error) {
+	if !bytes.HasPrefix(data[i:], []byte("<<")) {
+		return nil, 0, syntaxError(i)
+	}
+
+	var d dict
+	for i = skipSpace(data, i+2); !bytes.HasPrefix(data[i:], []byte(">>")); i = skipSpace(data, i) {
+		if i >= len(data) || data[i] != '/' {
+			return nil, 0, syntaxError(i)
+		}
+		keyEnd := regularEnd(data, i+1)
+		valueStart := skipSpace(data, keyEnd)
+		end, err := valueEnd(data, valueStart)
+		if err != nil {
+			return nil, 0, err
+		}
+		d = append(d, dictEntry{key: string(data[i:keyEnd]), value: string(data[valueStart:end])})
+		i = end
+	}
+	return d, i + 2, nil
+}
+
+// encodeText writes a text string, in UTF-16 when it is not plain ASCII.
+func encodeText(text string) string {
+	ascii := true
+	for i := 0; i < len(text); i++ {
+		if text[i] < 0x20 || text[i] > 0x7e {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return "(" + strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`).Replace(text) + ")"
+	}
+
+	var hex strings.Builder
+	hex.WriteString("<FEFF")
+	for _, unit := range utf16.Encode([]rune(text)) {
+		fmt.Fprintf(&hex, "%04X", unit)
+	}
+	hex.WriteString(">")
+	return hex.String()
+}
+
+// decodeText reads a text string written as a literal or a hexadecimal string.
+func decodeText(value string) (string, bool) {
+	var raw []byte
+	switch {
+	case strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")"):
+		raw = unescapeLiteral(value[1 : len(value)-1])
+	case strings.HasPrefix(value, "<") && strings.HasSuffix(value, ">"):
+		digits := strings.Join(strings.Fields(value[1:len(value)-1]), "")
+		if len(digits)%2 == 1 {
+			digits += "0"
+		}
+		for i := 0; i < len(digits); i += 2 {
+			b, err := strconv.ParseUint(digits[i:i+2], 16, 8)
+			if err != nil {
+				return "", false
+			}
+			raw = append(raw, byte(b))
+		}
+	default:
+		return "", false
+	}
+
+	if bytes.HasPrefix(raw, []byte{0xfe, 0xff}) {
+		units := make([]uint16, 0, len(raw)/2)
+		for i := 2; i+1 < len(raw); i += 2 {
+			units = append(units, uint16(raw[i])<<8|uint16(raw[i+1]))
+		}
+		return string(utf16.Decode(units)), true
+	}
+	// PDFDocEncoding matches Latin-1 for the text Chrome writes
+	runes := make([]rune, len(raw))
+	for i, b := range raw {
+		runes[i] = rune(b)
+	}
+	return string(runes), true
+}
+
+func unescapeLiteral(literal string) []byte {
+	var out []byte
+	for i := 0; i < len(literal); i++ {
+		c := literal[i]
+		if c != '\\' || i+1 == len(literal) {
+			out = append(out, c)
+			continue
+		}
+
+		i++
+		switch c = literal[i]; c {
+		case 'n':
+			out = append(out, '\n')
+		case 'r':
+			out = append(out, '\r')
+		case 't':
+			out = append(out, '\t')
+		case 'b':
+			out = append(out, '\b')
+		case 'f':
+			out = append(out, '\f')
+		case '\r':
+			// a backslash at the end of a line continues the string
+			if i+1 < len(literal) && literal[i+1] == '\n' {
+				i++
+			}
+		case '\n':
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			code := 0
+			for j := 0; j < 3 && i < len(literal) && literal[i] >= '0' && literal[i] <= '7'; j++ {
+				code = code*8 + int(literal[i]-'0')
+				i++
+			}
+			i--
+			out = append(out, byte(code))
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,310 @@
+package pdf
+
+import (
+	"context"
+	"io/fs"
+	"sync"
+	"time"
+
+	"github.com/chromedp/chromedp"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPoolSize    = 4
+	healthCheckTimeout = 5 * time.Second
+	restartBackoff     = 2 * time.Second
+)
+
+// ErrPoolClosed is returned when a render is requested from a closed pool.
+var ErrPoolClosed = errors.New("chrome pool is closed")
+
+// PoolConfig configures the headless Chrome pool.
+type PoolConfig struct {
+	// Size is the maximum number of tabs rendering at the same time.
+	Size int
+	// HealthCheckInterval is how often the browser is probed, zero disables probing.
+	HealthCheckInterval time.Duration
+	// ExecPath overrides the Chrome binary lookup when set.
+	ExecPath string
+	// Assets are served to documents at AssetOrigin, every other request of a document is blocked.
+	Assets      fs.FS
+	AssetOrigin string
+}
+
+// driver starts browsers, opens their tabs and runs actions in them. It is Chrome outside
+// of the tests of the pool.
+type driver struct {
+	// launch starts a browser, the returned context is done when the browser exits
+	launch func() (context.Context, context.CancelFunc, error)
+	open   func(browserCtx context.Context) (context.Context, context.CancelFunc, error)
+	run    func(ctx context.Context, actions ...chromedp.Action) error
+}
+
+type tab struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	gen    uint64
+}
+
+// Pool keeps one long-lived headless Chrome and hands out a bounded number of tabs.
+// Callers queue when every tab is busy; the browser is restarted when it crashes
+// or stops answering health checks.
+type Pool struct {
+	cfg    PoolConfig
+	logger *zap.Logger
+	driver driver
+
+	mu            sync.RWMutex
+	gen           uint64
+	browserCtx    context.Context
+	browserCancel context.CancelFunc
+
+	tabs      chan *tab
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewPool starts Chrome and returns a pool with cfg.Size tab slots.
+func NewPool(cfg PoolConfig, logger *zap.Logger) (*Pool, error) {
+	p := &Pool{cfg: cfg, logger: logger}
+	p.driver = driver{launch: p.launchChrome, open: p.openChromeTab, run: chromedp.Run}
+
+	if err := p.init(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// init starts the browser of the driver and the watch over it.
+func (p *Pool) init() error {
+	if p.cfg.Size <= 0 {
+		p.cfg.Size = defaultPoolSize
+	}
+	p.tabs = make(chan *tab, p.cfg.Size)
+	p.done = make(chan struct{})
+
+	if err := p.start(); err != nil {
+		return err
+	}
+
+	// nil slots are opened lazily on first use
+	for i := 0; i < p.cfg.Size; i++ {
+		p.tabs <- nil
+	}
+
+	go p.watch()
+
+	return nil
+}
+
+// Size returns the number of tab slots.
+func (p *Pool) Size() int {
+	return p.cfg.Size
+}
+
+// Run executes actions in a free tab, waiting until one is available or ctx is done.
+func (p *Pool) Run(ctx context.Context, actions ...chromedp.Action) error {
+	// a closed pool still holds its free tabs, select would pick one of them at random
+	select {
+	case <-p.done:
+		return ErrPoolClosed
+	default:
+	}
+
+	var t *tab
+	select {
+	case t = <-p.tabs:
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "Pool.Run - waiting for a free tab")
+	case <-p.done:
+		return ErrPoolClosed
+	}
+
+	t, err := p.ensureTab(t)
+	if err == nil {
+		err = p.runInTab(ctx, t, actions)
+	}
+	if err != nil && t != nil {
+		// a tab that failed may be left mid-navigation, open a clean one next time
+		t.cancel()
+		t = nil
+	}
+
+	p.tabs <- t
+
+	return err
+}
+
+// Close shuts the browser down. Pending and future calls to Run fail with ErrPoolClosed.
+func (p *Pool) Close() {
+	p.closeOnce.Do(func() {
+		close(p.done)
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		p.browserCancel()
+	})
+}
+
+func (p *Pool) runInTab(ctx context.Context, t *tab, actions []chromedp.Action) error {
+	runCtx, cancel := context.WithCancel(t.ctx)
+	defer cancel()
+
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+
+	if err := p.driver.run(runCtx, actions...); err != nil {
+		return errors.Wrap(err, "Pool.Run - chromedp.Run")
+	}
+
+	return nil
+}
+
+// ensureTab returns t if it belongs to the running browser, otherwise opens a new tab.
+func (p *Pool) ensureTab(t *tab) (*tab, error) {
+	p.mu.RLock()
+	gen, browserCtx := p.gen, p.browserCtx
+	p.mu.RUnlock()
+
+	if t != nil && t.gen == gen && t.ctx.Err() == nil {
+		return t, nil
+	}
+	if t != nil {
+		t.cancel()
+	}
+
+	tabCtx, cancel, err := p.driver.open(browserCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tab{ctx: tabCtx, cancel: cancel, gen: gen}, nil
+}
+
+func (p *Pool) start() error {
+	browserCtx, browserCancel, err := p.driver.launch()
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	select {
+	case <-p.done:
+		p.mu.Unlock()
+		browserCancel()
+		return ErrPoolClosed
+	default:
+	}
+	oldBrowserCancel := p.browserCancel
+	p.gen++
+	p.browserCtx = browserCtx
+	p.browserCancel = browserCancel
+	p.mu.Unlock()
+
+	if oldBrowserCancel != nil {
+		oldBrowserCancel()
+	}
+
+	return nil
+}
+
+func (p *Pool) launchChrome() (context.Context, context.CancelFunc, error) {
+	opts := chromedp.DefaultExecAllocatorOptions[:]
+	if p.cfg.ExecPath != "" {
+		opts = append(opts, chromedp.ExecPath(p.cfg.ExecPath))
+	}
+
+	allocCtx, allocCancel := chromedp.NewExecAllocator(context.Background(), opts...)
+	browserCtx, browserCancel := chromedp.NewContext(allocCtx)
+	cancel := func() {
+		browserCancel()
+		allocCancel()
+	}
+
+	if err := chromedp.Run(browserCtx); err != nil {
+		cancel()
+		return nil, nil, errors.Wrap(err, "Pool.launchChrome - chromedp.Run")
+	}
+
+	return browserCtx, cancel, nil
+}
+
+func (p *Pool) openChromeTab(browserCtx context.Context) (context.Context, context.CancelFunc, error) {
+	tabCtx, cancel := chromedp.NewContext(browserCtx)
+	// the first Run creates the target, it must not carry a request deadline
+	if err := chromedp.Run(tabCtx); err != nil {
+		cancel()
+		return nil, nil, errors.Wrap(err, "Pool.openChromeTab - chromedp.Run")
+	}
+	if err := p.interceptRequests(tabCtx); err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	return tabCtx, cancel, nil
+}
+
+func (p *Pool) watch() {
+	var healthCheck <-chan time.Time
+	if p.cfg.HealthCheckInterval > 0 {
+		ticker := time.NewTicker(p.cfg.HealthCheckInterval)
+		defer ticker.Stop()
+		healthCheck = ticker.C
+	}
+
+	for {
+		p.mu.RLock()
+		crashed := p.browserCtx.Done()
+		p.mu.RUnlock()
+
+		select {
+		case <-p.done:
+			return
+		case <-crashed:
+			p.logger.Error("chrome pool: browser exited, restarting")
+			p.restart()
+		case <-healthCheck:
+			if err := p.ping(); err != nil {
+				p.logger.Error("chrome pool: health check failed, restarting", zap.Error(err))
+				p.restart()
+			}
+		}
+	}
+}
+
+func (p *Pool) restart() {
+	for {
+		err := p.start()
+		if err == nil {
+			p.logger.Info("chrome pool: browser restarted")
+			return
+		}
+		p.logger.Error("chrome pool: restart failed", zap.Error(err))
+
+		select {
+		case <-p.done:
+			return
+		case <-time.After(restartBackoff):
+		}
+	}
+}
+
+func (p *Pool) ping() error {
+	p.mu.RLock()
+	browserCtx := p.browserCtx
+	p.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(browserCtx, healthCheckTimeout)
+	defer cancel()
+
+	var result int
+	if err := p.driver.run(ctx, chromedp.Evaluate("1", &result)); err != nil {
+		return errors.Wrap(err, "Pool.ping - chromedp.Run")
+	}
+
+	return nil
+}
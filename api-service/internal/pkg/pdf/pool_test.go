@@ -0,0 +1,264 @@
+package pdf
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/chromedp"
+	"github.com/mailru/easyjson"
+	"go.uber.org/zap"
+)
+
+// fakeBrowser answers the commands of the health check in place of Chrome.
+type fakeBrowser struct {
+	cancel context.CancelFunc
+	// hung makes every command fail as if the browser stopped answering
+	hung atomic.Bool
+}
+
+func (b *fakeBrowser) Execute(ctx context.Context, method string, params easyjson.Marshaler, res easyjson.Unmarshaler) error {
+	if b.hung.Load() {
+		return errors.New("browser does not answer")
+	}
+	if res == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(`{"result":{"type":"number","value":1}}`), res)
+}
+
+// fakeDriver runs actions without a browser, the contexts of its tabs are done when
+// their browser exits like those of Chrome.
+type fakeDriver struct {
+	mu       sync.Mutex
+	browsers []*fakeBrowser
+	tabs     int
+}
+
+func (d *fakeDriver) launch() (context.Context, context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	browser := &fakeBrowser{cancel: cancel}
+
+	d.mu.Lock()
+	d.browsers = append(d.browsers, browser)
+	d.mu.Unlock()
+
+	return cdp.WithExecutor(ctx, browser), cancel, nil
+}
+
+func (d *fakeDriver) open(browserCtx context.Context) (context.Context, context.CancelFunc, error) {
+	d.mu.Lock()
+	d.tabs++
+	d.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(browserCtx)
+	return ctx, cancel, nil
+}
+
+func (d *fakeDriver) run(ctx context.Context, actions ...chromedp.Action) error {
+	for _, action := range actions {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := action.Do(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// counts returns the number of browsers launched and of tabs opened so far.
+func (d *fakeDriver) counts() (browsers, tabs int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.browsers), d.tabs
+}
+
+func (d *fakeDriver) browser(i int) *fakeBrowser {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.browsers[i]
+}
+
+func testPool(t *testing.T, cfg PoolConfig) (*Pool, *fakeDriver) {
+	t.Helper()
+
+	d := &fakeDriver{}
+	pool := &Pool{cfg: cfg, logger: zap.NewNop(), driver: driver{launch: d.launch, open: d.open, run: d.run}}
+	if err := pool.init(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+
+	return pool, d
+}
+
+// holdTab occupies a tab of the pool until release is called.
+func holdTab(t *testing.T, pool *Pool) (release func()) {
+	t.Helper()
+
+	held, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		_ = pool.Run(context.Background(), chromedp.ActionFunc(func(context.Context) error {
+			close(held)
+			<-done
+			return nil
+		}))
+	}()
+
+	select {
+	case <-held:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the tab was not handed out")
+	}
+	return func() { close(done) }
+}
+
+func noop(context.Context) error { return nil }
+
+// waitFor polls cond until it holds or the test times out.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting until %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestPoolBlocksWhenExhausted(t *testing.T) {
+	pool, _ := testPool(t, PoolConfig{Size: 1})
+	release := holdTab(t, pool)
+
+	queued := make(chan error, 1)
+	go func() { queued <- pool.Run(context.Background(), chromedp.ActionFunc(noop)) }()
+
+	select {
+	case err := <-queued:
+		t.Fatalf("Run() = %v while every tab is busy, want it to wait", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	release()
+	select {
+	case err := <-queued:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() kept waiting after the tab was released")
+	}
+}
+
+func TestPoolCancelWhileWaiting(t *testing.T) {
+	pool, d := testPool(t, PoolConfig{Size: 1})
+	release := holdTab(t, pool)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := pool.Run(ctx, chromedp.ActionFunc(noop)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Run() = %v, want the deadline of the request", err)
+	}
+
+	// the request that gave up must neither take the slot with it nor close the tab
+	release()
+	if err := pool.Run(context.Background(), chromedp.ActionFunc(noop)); err != nil {
+		t.Fatal(err)
+	}
+	if _, tabs := d.counts(); tabs != 1 {
+		t.Errorf("%d tabs were opened, want the held one to be reused", tabs)
+	}
+}
+
+func TestPoolReusesTabs(t *testing.T) {
+	pool, d := testPool(t, PoolConfig{Size: 2})
+
+	for i := 0; i < 10; i++ {
+		if err := pool.Run(context.Background(), chromedp.ActionFunc(noop)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// every slot opens its tab once
+	if _, tabs := d.counts(); tabs != 2 {
+		t.Errorf("%d tabs were opened for 10 requests, want one per slot", tabs)
+	}
+}
+
+func TestPoolRecreatesTabs(t *testing.T) {
+	pool, d := testPool(t, PoolConfig{Size: 1})
+
+	if err := pool.Run(context.Background(), chromedp.ActionFunc(noop)); err != nil {
+		t.Fatal(err)
+	}
+
+	// a tab whose actions failed may be left mid-navigation, it is replaced
+	failure := errors.New("render failed")
+	if err := pool.Run(context.Background(), chromedp.ActionFunc(func(context.Context) error { return failure })); !errors.Is(err, failure) {
+		t.Fatalf("Run() = %v, want %v", err, failure)
+	}
+	if err := pool.Run(context.Background(), chromedp.ActionFunc(noop)); err != nil {
+		t.Fatal(err)
+	}
+	if _, tabs := d.counts(); tabs != 2 {
+		t.Errorf("%d tabs were opened, want a new one after the failure", tabs)
+	}
+
+	// the browser crashes, the tab of the old browser is replaced by one of the new
+	d.browser(0).cancel()
+	waitFor(t, "the browser is restarted", func() bool {
+		browsers, _ := d.counts()
+		return browsers == 2
+	})
+
+	err := pool.Run(context.Background(), chromedp.ActionFunc(func(ctx context.Context) error {
+		return ctx.Err()
+	}))
+	if err != nil {
+		t.Fatalf("Run() after a crash = %v", err)
+	}
+	if _, tabs := d.counts(); tabs != 3 {
+		t.Errorf("%d tabs were opened, want a new one in the restarted browser", tabs)
+	}
+}
+
+func TestPoolHealthCheck(t *testing.T) {
+	pool, d := testPool(t, PoolConfig{Size: 1, HealthCheckInterval: 10 * time.Millisecond})
+
+	if err := pool.ping(); err != nil {
+		t.Fatalf("ping() = %v, want the healthy browser to answer", err)
+	}
+
+	// the process is alive but stopped answering, only the health check notices
+	d.browser(0).hung.Store(true)
+	waitFor(t, "the hung browser is restarted", func() bool {
+		browsers, _ := d.counts()
+		return browsers >= 2
+	})
+
+	if err := pool.ping(); err != nil {
+		t.Fatalf("ping() = %v after the restart", err)
+	}
+	if err := pool.Run(context.Background(), chromedp.ActionFunc(noop)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPoolClosed(t *testing.T) {
+	pool, _ := testPool(t, PoolConfig{Size: 2})
+	pool.Close()
+
+	// free tabs are left in the pool, they must not be handed out
+	for i := 0; i < 10; i++ {
+		if err := pool.Run(context.Background(), chromedp.ActionFunc(noop)); !errors.Is(err, ErrPoolClosed) {
+			t.Fatalf("Run() = %v on a closed pool, want ErrPoolClosed", err)
+		}
+	}
+}
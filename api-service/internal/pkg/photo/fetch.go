@@ -0,0 +1,143 @@
+package photo
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultFetchTimeout = 5 * time.Second
+	maxRedirects        = 3
+	dataURIPrefix       = "data:"
+)
+
+// ErrRejected is wrapped by the errors of photos that cannot be printed whatever the
+// network does, such as photos of hosts that are not allowed.
+var ErrRejected = errors.New("the photo is rejected")
+
+// Fetcher downloads the photos printed on resumes so that the renderer never reaches
+// the network itself. Only the allowed hosts are asked, which keeps user supplied
+// URLs away from the internal network.
+type Fetcher struct {
+	hosts  map[string]bool
+	client *http.Client
+}
+
+// NewFetcher returns a fetcher asking hosts only, each download is bounded by timeout.
+func NewFetcher(hosts []string, timeout time.Duration) *Fetcher {
+	if timeout <= 0 {
+		timeout = defaultFetchTimeout
+	}
+
+	f := &Fetcher{hosts: make(map[string]bool, len(hosts))}
+	for _, host := range hosts {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			f.hosts[host] = true
+		}
+	}
+	f.client = &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errors.Wrap(ErrRejected, "too many redirects")
+			}
+			// a redirect must not lead out of the allowed hosts
+			return f.allowed(req.URL)
+		},
+	}
+
+	return f
+}
+
+func (f *Fetcher) allowed(u *url.URL) error {
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return errors.Wrap(ErrRejected, fmt.Sprintf("photos are not fetched over %q", u.Scheme))
+	}
+	if !f.hosts[strings.ToLower(u.Hostname())] {
+		return errors.Wrap(ErrRejected, fmt.Sprintf("%s is not an allowed photo host, upload the photo first", u.Hostname()))
+	}
+	return nil
+}
+
+// DataURI returns the photo rawURL links to as a data URI. Photos already written as
+// data URIs are checked and returned unchanged.
+func (f *Fetcher) DataURI(ctx context.Context, rawURL string) (string, error) {
+	if strings.HasPrefix(rawURL, dataURIPrefix) {
+		if _, err := decodeDataURI(rawURL); err != nil {
+			return "", errors.Wrap(ErrRejected, err.Error())
+		}
+		return rawURL, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", errors.Wrap(ErrRejected, "the photo URL is malformed")
+	}
+	if err := f.allowed(u); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", errors.Wrap(err, "DataURI - http.NewRequestWithContext")
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		if errors.Is(err, ErrRejected) {
+			return "", errors.Wrap(ErrRejected, "the photo host redirected out of the allowed hosts")
+		}
+		return "", errors.Wrap(err, "DataURI - http.Client.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Wrap(ErrRejected, fmt.Sprintf("the photo host answered %s", resp.Status))
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxSize+1))
+	if err != nil {
+		return "", errors.Wrap(err, "DataURI - io.ReadAll")
+	}
+	if len(data) > MaxSize {
+		return "", errors.Wrap(ErrRejected, fmt.Sprintf("a photo must not exceed %d MB", MaxSize>>20))
+	}
+	format, err := Detect(data)
+	if err != nil {
+		return "", errors.Wrap(ErrRejected, err.Error())
+	}
+
+	return fmt.Sprintf("data:image/%s;base64,%s", format, base64.StdEncoding.EncodeToString(data)), nil
+}
+
+// decodeDataURI returns the photo of a base64 data URI, only the formats of Detect are accepted.
+func decodeDataURI(uri string) ([]byte, error) {
+	header, payload, ok := strings.Cut(strings.TrimPrefix(uri, dataURIPrefix), ",")
+	if !ok || !strings.HasSuffix(header, ";base64") {
+		return nil, errors.New("photos written as data URIs must be base64 encoded")
+	}
+	if base64.StdEncoding.DecodedLen(len(payload)) > MaxSize {
+		return nil, errors.New(fmt.Sprintf("a photo must not exceed %d MB", MaxSize>>20))
+	}
+
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, errors.New("the data URI of the photo is malformed")
+	}
+	format, err := Detect(data)
+	if err != nil {
+		return nil, err
+	}
+	if header != "image/"+format+";base64" {
+		return nil, errors.New(fmt.Sprintf("the data URI of the photo is declared %s but holds %s", strings.TrimSuffix(header, ";base64"), format))
+	}
+
+	return data, nil
+}
@@ -0,0 +1,92 @@
+package photo
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFetcherDataURI(t *testing.T) {
+	jpegData := encodeJPEG(t, portrait(8, 8))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/photo.jpg", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(jpegData)
+	})
+	mux.HandleFunc("/page.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html><body>internal</body></html>"))
+	})
+	mux.HandleFunc("/away", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Write(jpegData)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fetcher := NewFetcher([]string{u.Hostname()}, 100*time.Millisecond)
+	dataURI := "data:image/jpeg;base64," + base64.StdEncoding.EncodeToString(jpegData)
+
+	tests := []struct {
+		name         string
+		url          string
+		want         string
+		wantRejected bool
+		wantErr      bool
+	}{
+		{name: "allowed host", url: server.URL + "/photo.jpg", want: dataURI},
+		{name: "data URI", url: dataURI, want: dataURI},
+		{name: "foreign host", url: "http://10.0.0.1/photo.jpg", wantRejected: true},
+		{name: "file scheme", url: "file:///etc/passwd", wantRejected: true},
+		{name: "redirect out of the allowed hosts", url: server.URL + "/away", wantRejected: true},
+		{name: "not a photo", url: server.URL + "/page.html", wantRejected: true},
+		{name: "missing", url: server.URL + "/missing.jpg", wantRejected: true},
+		{name: "data URI of another type", url: "data:image/png;base64," + base64.StdEncoding.EncodeToString(jpegData), wantRejected: true},
+		{name: "data URI of html", url: "data:text/html;base64," + base64.StdEncoding.EncodeToString([]byte("<script>alert(1)</script>")), wantRejected: true},
+		{name: "timeout", url: server.URL + "/slow", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fetcher.DataURI(context.Background(), tt.url)
+			if tt.wantRejected || tt.wantErr {
+				if err == nil {
+					t.Fatalf("DataURI() = %.40q, want an error", got)
+				}
+				if rejected := errors.Is(err, ErrRejected); rejected != tt.wantRejected {
+					t.Fatalf("DataURI() error = %v, rejected %v, want %v", err, rejected, tt.wantRejected)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("DataURI() = %.60q, want %.60q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFetcherNormalizesHosts(t *testing.T) {
+	fetcher := NewFetcher([]string{" Media.CVMaker.uz ", ""}, 0)
+
+	if err := fetcher.allowed(&url.URL{Scheme: "https", Host: "media.cvmaker.uz"}); err != nil {
+		t.Error(err)
+	}
+	if err := fetcher.allowed(&url.URL{Scheme: "https", Host: "media.cvmaker.uz.evil.com"}); err == nil || !strings.Contains(err.Error(), "not an allowed photo host") {
+		t.Errorf("allowed() error = %v, want the host to be rejected", err)
+	}
+}
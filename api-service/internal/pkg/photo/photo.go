@@ -0,0 +1,142 @@
+package photo
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	"github.com/pkg/errors"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// MaxSize is the largest photo accepted for upload, in bytes
+	MaxSize = 10 << 20
+	// maxPixels keeps small files of huge images from being decoded
+	maxPixels   = 40_000_000
+	jpegQuality = 85
+)
+
+// Variants of a processed photo, every one is a square JPEG.
+const (
+	VariantThumbnail = "thumbnail"
+	VariantTemplate  = "template"
+)
+
+type Variant struct {
+	Name string
+	// Size is the side of the square in pixels, smaller photos are not enlarged
+	Size int
+}
+
+// Variants are the sizes a photo is stored in, the template one is printed on resumes.
+var Variants = []Variant{
+	{Name: VariantThumbnail, Size: 128},
+	{Name: VariantTemplate, Size: 480},
+}
+
+// formats are the image types accepted, keyed by the content type sniffed from their magic bytes
+var formats = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+	"image/webp": "webp",
+}
+
+var variantURL = regexp.MustCompile(`^(.+/[0-9a-f-]{36})-(` + variantNames() + `)\.jpg$`)
+
+func variantNames() string {
+	names := make([]string, 0, len(Variants))
+	for _, variant := range Variants {
+		names = append(names, variant.Name)
+	}
+	return strings.Join(names, "|")
+}
+
+// Detect returns the format of data read from its magic bytes, whatever the file is
+// named. SVG is rejected, it may carry scripts and cannot be cropped.
+func Detect(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if format, ok := formats[contentType]; ok {
+		return format, nil
+	}
+
+	head := bytes.ToLower(data[:min(len(data), 1024)])
+	if strings.HasPrefix(contentType, "text/") && bytes.Contains(head, []byte("<svg")) {
+		return "", errors.New("SVG photos are not accepted, upload a JPEG, PNG or WebP image")
+	}
+	return "", errors.New(fmt.Sprintf("%s is not a photo, upload a JPEG, PNG or WebP image", contentType))
+}
+
+// Process turns an uploaded photo into its variants keyed by name. The photo is turned
+// upright from its EXIF orientation and encoded again, which leaves EXIF and GPS
+// metadata behind.
+func Process(data []byte) (map[string][]byte, error) {
+	if len(data) > MaxSize {
+		return nil, errors.New(fmt.Sprintf("a photo must not exceed %d MB", MaxSize>>20))
+	}
+	if _, err := Detect(data); err != nil {
+		return nil, err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "the photo cannot be read")
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, errors.New(fmt.Sprintf("a photo must not exceed %d megapixels", maxPixels/1_000_000))
+	}
+
+	img, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, errors.Wrap(err, "the photo cannot be read")
+	}
+	square := faceCrop(img)
+
+	variants := make(map[string][]byte, len(Variants))
+	for _, variant := range Variants {
+		size := min(variant.Size, square.Bounds().Dx())
+		resized := imaging.Resize(square, size, size, imaging.Lanczos)
+
+		// JPEG has no transparency, transparent pixels would turn black
+		flat := imaging.Overlay(imaging.New(size, size, color.White), resized, image.Pt(0, 0), 1)
+
+		var buf bytes.Buffer
+		if err := imaging.Encode(&buf, flat, imaging.JPEG, imaging.JPEGQuality(jpegQuality)); err != nil {
+			return nil, errors.Wrap(err, "Process - imaging.Encode")
+		}
+		variants[variant.Name] = buf.Bytes()
+	}
+
+	return variants, nil
+}
+
+// faceCrop cuts the largest square out of img. Portraits lose more of their bottom
+// than of their top, faces are usually in the upper part of the photo.
+func faceCrop(img image.Image) image.Image {
+	bounds := img.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	x := (bounds.Dx() - side) / 2
+	y := (bounds.Dy() - side) / 4
+
+	return imaging.Crop(img, image.Rect(x, y, x+side, y+side).Add(bounds.Min))
+}
+
+// ObjectName is the name variant of the photo id is stored under.
+func ObjectName(id, variant string) string {
+	return fmt.Sprintf("%s-%s.jpg", id, variant)
+}
+
+// VariantURL returns the URL of variant of the processed photo url links to. Other
+// URLs, such as photos uploaded before they were processed, are returned unchanged.
+func VariantURL(url, variant string) string {
+	match := variantURL.FindStringSubmatch(url)
+	if match == nil {
+		return url
+	}
+	return match[1] + "-" + variant + ".jpg"
+}
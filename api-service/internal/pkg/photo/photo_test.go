@@ -0,0 +1,173 @@
+package photo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// portrait is taller than wide with a red top half, where the face would be.
+func portrait(width, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			c := color.NRGBA{B: 255, A: 255}
+			if y < height/2 {
+				c = color.NRGBA{R: 255, A: 255}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// withEXIF inserts an APP1 segment holding orientation and a GPS tag after the SOI marker of a JPEG.
+func withEXIF(t *testing.T, data []byte, orientation uint16) []byte {
+	t.Helper()
+
+	var tiff bytes.Buffer
+	tiff.WriteString("MM\x00\x2a")
+	binary.Write(&tiff, binary.BigEndian, uint32(8))
+	binary.Write(&tiff, binary.BigEndian, uint16(2))
+	// orientation, SHORT
+	binary.Write(&tiff, binary.BigEndian, []uint16{0x0112, 3})
+	binary.Write(&tiff, binary.BigEndian, uint32(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{orientation, 0})
+	// GPS IFD pointer, LONG, its content does not matter here
+	binary.Write(&tiff, binary.BigEndian, []uint16{0x8825, 4})
+	binary.Write(&tiff, binary.BigEndian, []uint32{1, 0})
+	binary.Write(&tiff, binary.BigEndian, uint32(0))
+
+	payload := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	if !bytes.HasPrefix(data, []byte{0xFF, 0xD8}) {
+		t.Fatal("not a JPEG")
+	}
+	return append(append([]byte{0xFF, 0xD8}, segment...), data[2:]...)
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDetect(t *testing.T) {
+	var pngData bytes.Buffer
+	if err := png.Encode(&pngData, portrait(4, 4)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    string
+		wantErr bool
+	}{
+		{name: "jpeg", data: encodeJPEG(t, portrait(4, 4)), want: "jpeg"},
+		{name: "png", data: pngData.Bytes(), want: "png"},
+		{name: "svg", data: []byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`), wantErr: true},
+		{name: "svg with prolog", data: []byte(`<?xml version="1.0"?><SVG onload="alert(1)"/>`), wantErr: true},
+		{name: "html named photo.jpg", data: []byte(`<html><body>hello</body></html>`), wantErr: true},
+		{name: "empty", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Detect(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Detect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Detect() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProcess(t *testing.T) {
+	// stored on its side, orientation 6 turns it into a 600x900 portrait
+	sideways := image.NewNRGBA(image.Rect(0, 0, 900, 600))
+	upright := portrait(600, 900)
+	for y := 0; y < 900; y++ {
+		for x := 0; x < 600; x++ {
+			sideways.Set(y, 599-x, upright.At(x, y))
+		}
+	}
+	data := withEXIF(t, encodeJPEG(t, sideways), 6)
+
+	variants, err := Process(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, variant := range Variants {
+		got, ok := variants[variant.Name]
+		if !ok {
+			t.Fatalf("variant %s missing", variant.Name)
+		}
+		if bytes.Contains(got, []byte("Exif")) {
+			t.Errorf("variant %s kept the EXIF metadata", variant.Name)
+		}
+
+		img, err := jpeg.Decode(bytes.NewReader(got))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if size := img.Bounds().Size(); size.X != variant.Size || size.Y != variant.Size {
+			t.Errorf("variant %s is %v, want a %d pixels square", variant.Name, size, variant.Size)
+		}
+
+		// the crop keeps more of the top, the upright face is above the middle
+		r, _, b, _ := img.At(variant.Size/2, variant.Size/3).RGBA()
+		if r < b {
+			t.Errorf("variant %s is not upright or lost the top of the portrait", variant.Name)
+		}
+	}
+}
+
+func TestProcessDoesNotEnlargeSmallPhotos(t *testing.T) {
+	variants, err := Process(encodeJPEG(t, portrait(200, 300)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := jpeg.Decode(bytes.NewReader(variants[VariantTemplate]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := img.Bounds().Size(); size.X != 200 || size.Y != 200 {
+		t.Errorf("template variant is %v, want 200x200", size)
+	}
+}
+
+func TestVariantURL(t *testing.T) {
+	const base = "https://media.cvmaker.uz/resumes/1b4e28ba-2fa1-11d2-883f-0016d3cca427"
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: base + "-thumbnail.jpg", want: base + "-template.jpg"},
+		{url: base + "-template.jpg", want: base + "-template.jpg"},
+		{url: "https://media.cvmaker.uz/resumes/1b4e28ba-2fa1-11d2-883f-0016d3cca427.png", want: "https://media.cvmaker.uz/resumes/1b4e28ba-2fa1-11d2-883f-0016d3cca427.png"},
+		{url: "https://example.com/me-thumbnail.jpg", want: "https://example.com/me-thumbnail.jpg"},
+		{url: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := VariantURL(tt.url, VariantTemplate); got != tt.want {
+			t.Errorf("VariantURL(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
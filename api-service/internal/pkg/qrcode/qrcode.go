@@ -0,0 +1,91 @@
+package qrcode
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"strings"
+
+	"github.com/pkg/errors"
+	"rsc.io/qr"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+const (
+	// quietZone is the blank margin scanners need around the code, in modules
+	quietZone = 4
+	// maxLinkLen keeps the code small enough to be scanned from a printed page
+	maxLinkLen = 300
+)
+
+// Link returns the link the QR code of the resume points at, empty when options ask
+// for no code. hostedURL is where the resume is stored once it is generated.
+func Link(basics models.Basics, options models.QROptions, hostedURL string) (string, error) {
+	var link string
+	switch options.Target {
+	case models.QRTargetNone:
+		return "", nil
+	case models.QRTargetURL:
+		link = basics.URL
+		if strings.TrimSpace(link) == "" {
+			return "", errors.New("the QR code points at basics.url, which is empty")
+		}
+	case models.QRTargetProfile:
+		for _, profile := range basics.Profiles {
+			if strings.EqualFold(strings.TrimSpace(profile.Network), strings.TrimSpace(options.Network)) {
+				link = profile.URL
+				break
+			}
+		}
+		if strings.TrimSpace(link) == "" {
+			return "", errors.New(fmt.Sprintf("the QR code points at the %q profile, which has no URL", options.Network))
+		}
+	case models.QRTargetResume:
+		link = hostedURL
+		if link == "" {
+			return "", errors.New("the QR code points at the stored resume, which has no URL")
+		}
+	default:
+		return "", errors.New(fmt.Sprintf("unknown QR code target %q", options.Target))
+	}
+
+	link = strings.TrimSpace(link)
+	if len(link) > maxLinkLen {
+		return "", errors.New(fmt.Sprintf("the link of the QR code must not exceed %d characters", maxLinkLen))
+	}
+
+	return link, nil
+}
+
+// SVG encodes text in a QR code drawn as an inline SVG, one unit per module. The size
+// of the code is left to the stylesheet of the template.
+func SVG(text string) (template.HTML, error) {
+	code, err := qr.Encode(text, qr.M)
+	if err != nil {
+		return "", errors.Wrap(err, "SVG - qr.Encode")
+	}
+
+	size := code.Size + 2*quietZone
+
+	// dark modules of a row are merged into runs to keep the path short
+	var path strings.Builder
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			if !code.Black(x, y) {
+				continue
+			}
+			run := 1
+			for x+run < code.Size && code.Black(x+run, y) {
+				run++
+			}
+			fmt.Fprintf(&path, "M%d %dh%dv1h-%dz", x+quietZone, y+quietZone, run, run)
+			x += run
+		}
+	}
+
+	return template.HTML(fmt.Sprintf(
+		`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges" role="img" aria-label="%s"><rect width="%d" height="%d" fill="#fff"/><path d="%s" fill="#000"/></svg>`,
+		size, size, html.EscapeString(text), size, size, path.String(),
+	)), nil
+}
@@ -0,0 +1,111 @@
+package qrcode
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"rsc.io/qr"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+func TestLink(t *testing.T) {
+	basics := models.Basics{
+		URL: "https://example.com",
+		Profiles: []models.Profile{
+			{Network: "GitHub", URL: "https://github.com/candidate"},
+			{Network: "LinkedIn", URL: "https://linkedin.com/in/candidate"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		basics    models.Basics
+		options   models.QROptions
+		hostedURL string
+		want      string
+		wantErr   bool
+	}{
+		{name: "no code", basics: basics},
+		{name: "url", basics: basics, options: models.QROptions{Target: models.QRTargetURL}, want: "https://example.com"},
+		{name: "profile", basics: basics, options: models.QROptions{Target: models.QRTargetProfile, Network: "linkedin"}, want: "https://linkedin.com/in/candidate"},
+		{name: "resume", basics: basics, options: models.QROptions{Target: models.QRTargetResume}, hostedURL: "https://media.example.com/resume.pdf", want: "https://media.example.com/resume.pdf"},
+		{name: "empty url", options: models.QROptions{Target: models.QRTargetURL}, wantErr: true},
+		{name: "unknown profile", basics: basics, options: models.QROptions{Target: models.QRTargetProfile, Network: "Twitter"}, wantErr: true},
+		{name: "resume not stored", basics: basics, options: models.QROptions{Target: models.QRTargetResume}, wantErr: true},
+		{name: "unknown target", basics: basics, options: models.QROptions{Target: "email"}, wantErr: true},
+		{name: "long link", basics: models.Basics{URL: "https://example.com/" + strings.Repeat("a", maxLinkLen)}, options: models.QROptions{Target: models.QRTargetURL}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Link(tt.basics, tt.options, tt.hostedURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Link() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Link() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+var runRe = regexp.MustCompile(`M(\d+) (\d+)h(\d+)v1h-(\d+)z`)
+
+func TestSVGDrawsEveryModule(t *testing.T) {
+	for _, text := range []string{"https://example.com", "https://media.cvmaker.uz/resumes/CandidateName1a2b3CVMaker.pdf"} {
+		t.Run(text, func(t *testing.T) {
+			svg, err := SVG(text)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var doc struct {
+				ViewBox string `xml:"viewBox,attr"`
+				Label   string `xml:"aria-label,attr"`
+				Path    struct {
+					D string `xml:"d,attr"`
+				} `xml:"path"`
+			}
+			if err := xml.Unmarshal([]byte(svg), &doc); err != nil {
+				t.Fatalf("SVG is not well-formed: %v", err)
+			}
+			if doc.Label != text {
+				t.Errorf("aria-label = %q, want %q", doc.Label, text)
+			}
+
+			code, err := qr.Encode(text, qr.M)
+			if err != nil {
+				t.Fatal(err)
+			}
+			size := code.Size + 2*quietZone
+			if want := fmt.Sprintf("0 0 %d %d", size, size); doc.ViewBox != want {
+				t.Errorf("viewBox = %q, want %q", doc.ViewBox, want)
+			}
+
+			drawn := make(map[[2]int]bool)
+			for _, run := range runRe.FindAllStringSubmatch(doc.Path.D, -1) {
+				x, _ := strconv.Atoi(run[1])
+				y, _ := strconv.Atoi(run[2])
+				n, _ := strconv.Atoi(run[3])
+				for i := 0; i < n; i++ {
+					drawn[[2]int{x + i - quietZone, y - quietZone}] = true
+				}
+			}
+			for y := 0; y < code.Size; y++ {
+				for x := 0; x < code.Size; x++ {
+					if drawn[[2]int{x, y}] != code.Black(x, y) {
+						t.Fatalf("module (%d, %d) drawn %v, want %v", x, y, drawn[[2]int{x, y}], code.Black(x, y))
+					}
+				}
+			}
+			if len(drawn) == 0 {
+				t.Fatal("no module drawn")
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package template
+
+import (
+	"fmt"
+	"html/template"
+	"reflect"
+	"text/template/parse"
+
+	"github.com/pkg/errors"
+)
+
+// maxSteps bounds the loop iterations and template calls of one execution of a custom
+// template. Nothing else in a template repeats, so it bounds the time it renders in.
+const maxSteps = 1 << 20
+
+// stepBudget counts the steps of one execution, templates are parsed again for every
+// execution so that each of them starts with a full budget.
+type stepBudget struct {
+	left int
+}
+
+// spend takes n steps from the budget.
+func (b *stepBudget) spend(n int) error {
+	if n > b.left {
+		b.left = 0
+		return errors.New(fmt.Sprintf("loops and template calls exceed %d steps", maxSteps))
+	}
+	b.left -= n
+	return nil
+}
+
+// loop is piped into range actions, it spends one step per iteration of the value ranged over.
+func (b *stepBudget) loop(value reflect.Value) (reflect.Value, error) {
+	v := value
+	for (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	var n int
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() > maxSteps {
+			n = maxSteps + 1
+		} else if v.Int() > 0 {
+			n = int(v.Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > maxSteps {
+			n = maxSteps + 1
+		} else {
+			n = int(v.Uint())
+		}
+	case reflect.Array, reflect.Slice, reflect.Map:
+		n = v.Len()
+	case reflect.Chan, reflect.Func:
+		return reflect.Value{}, errors.New(fmt.Sprintf("range over a %s is not allowed", v.Kind()))
+	}
+
+	return value, b.spend(n)
+}
+
+// call is piped into template actions, it spends one step per call.
+func (b *stepBudget) call(args ...reflect.Value) (reflect.Value, error) {
+	if err := b.spend(1); err != nil {
+		return reflect.Value{}, err
+	}
+	if len(args) == 0 {
+		return reflect.Value{}, nil
+	}
+	return args[0], nil
+}
+
+// budgetFuncs returns the functions spending a new budget, boundSteps pipes them into the actions.
+func budgetFuncs() template.FuncMap {
+	b := &stepBudget{left: maxSteps}
+	return template.FuncMap{
+		"_loopStep": b.loop,
+		"_callStep": b.call,
+	}
+}
+
+// boundSteps pipes the range and template actions of t into the budget of budgetFuncs,
+// an execution fails once it is spent. The funcs t was parsed with must include them.
+func boundSteps(t *template.Template) error {
+	// the commands are taken from a template of their own, nodes keep the tree they were parsed in
+	trees, err := parse.Parse("steps", `{{range . | _loopStep}}{{end}}{{template "steps" _callStep}}`, "", "", map[string]any(budgetFuncs()))
+	if err != nil {
+		return errors.Wrap(err, "boundSteps parse.Parse")
+	}
+	nodes := trees["steps"].Root.Nodes
+	loop := nodes[0].(*parse.RangeNode).Pipe.Cmds[1]
+	call := nodes[1].(*parse.TemplateNode).Pipe
+
+	for _, tmpl := range t.Templates() {
+		if tmpl.Tree != nil {
+			boundNode(tmpl.Tree.Root, loop, call)
+		}
+	}
+	return nil
+}
+
+func boundNode(node parse.Node, loop *parse.CommandNode, call *parse.PipeNode) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			boundNode(child, loop, call)
+		}
+	case *parse.RangeNode:
+		n.Pipe.Cmds = append(n.Pipe.Cmds, loop)
+		boundNode(n.List, loop, call)
+		boundNode(n.ElseList, loop, call)
+	case *parse.IfNode:
+		boundNode(n.List, loop, call)
+		boundNode(n.ElseList, loop, call)
+	case *parse.WithNode:
+		boundNode(n.List, loop, call)
+		boundNode(n.ElseList, loop, call)
+	case *parse.TemplateNode:
+		if n.Pipe == nil {
+			n.Pipe = call
+		} else {
+			n.Pipe.Cmds = append(n.Pipe.Cmds, call.Cmds[0])
+		}
+	}
+}
@@ -0,0 +1,405 @@
+package template
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/fonts"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/lang"
+)
+
+const (
+	// CustomPrefix starts the names of custom templates, the rest is the template id
+	CustomPrefix = "custom-"
+	// MaxCustomArchiveSize bounds the uploaded ZIP archive of a custom template
+	MaxCustomArchiveSize = 1 << 20
+
+	maxCustomFiles     = 32
+	maxCustomFileSize  = 256 << 10
+	maxCustomSize      = 2 << 20
+	maxCustomTemplates = 10
+	// maxRenderedSize bounds the HTML a custom template renders for the sample resume
+	maxRenderedSize = 5 << 20
+
+	// samplePhoto is a transparent GIF, it keeps the photo branches of a template executed
+	samplePhoto = "data:image/gif;base64,R0lGODlhAQABAIAAAAAAAP///yH5BAEAAAAALAAAAAABAAEAAAIBRAA7"
+)
+
+var (
+	// forbiddenTagRe matches elements that load or run content on their own.
+	forbiddenTagRe = regexp.MustCompile(`(?i)<\s*(script|iframe|frame|frameset|object|embed|applet|base|link|meta|portal)\b`)
+	// networkURLRe matches absolute and protocol-relative URLs, also right after an action, and stylesheet imports.
+	networkURLRe = regexp.MustCompile(`(?i)\b(?:https?|ftps?|wss?|file|blob)\s*:|(?:["'(=]|\}\})\s*//|@\s*(?:import|\\)`)
+	// anchorHrefRe matches the link of an anchor, following it is up to the reader of the PDF.
+	anchorHrefRe = regexp.MustCompile(`(?i)(<\s*a\b[^>]*?\bhref\s*=\s*)(?:"[^"]*"|'[^']*'|[^\s>]*)`)
+	// resourceRe captures the values the browser loads: media attributes, SVG references and CSS url().
+	resourceRe = regexp.MustCompile(`(?i)(?:\b(?:src|srcset|poster|background|data|xlink:href)|<\s*(?:image|use|feimage)\b[^>]*\bhref)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]*))|url\(\s*(?:"([^"]*)"|'([^']*)'|([^)]*))`)
+)
+
+// EnableCustomTemplates loads the custom templates stored in dir. Uploaded templates
+// are executed against the resume of samplePath before they are stored.
+func (tm *Manager) EnableCustomTemplates(dir, samplePath string) error {
+	data, err := os.ReadFile(samplePath)
+	if err != nil {
+		return errors.Wrap(err, "EnableCustomTemplates sample")
+	}
+
+	var sample models.Resume
+	if err := json.Unmarshal(data, &sample); err != nil {
+		return errors.Wrap(err, "EnableCustomTemplates sample")
+	}
+	// a remote photo in the output would hide the resources loaded by the template itself
+	sample.Basics.Image = samplePhoto
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return errors.Wrap(err, "EnableCustomTemplates MkdirAll")
+	}
+	owners, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.Wrap(err, "EnableCustomTemplates ReadDir")
+	}
+
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	for _, owner := range owners {
+		if !owner.IsDir() {
+			continue
+		}
+
+		manifests, err := loadManifests(filepath.Join(dir, owner.Name()), owner.Name())
+		if err != nil {
+			return err
+		}
+		for name, manifest := range manifests {
+			tm.manifests[name] = manifest
+		}
+	}
+
+	tm.CustomDir = dir
+	tm.sample = sample
+
+	return nil
+}
+
+// AddCustomTemplate validates the ZIP archive of a custom template and stores it for the owner.
+// The archive holds .gohtml partials and .css stylesheets, the entry file is the one
+// named with a leading underscore like the built-in templates.
+func (tm *Manager) AddCustomTemplate(owner, displayName, description string, archive []byte) (Manifest, error) {
+	if tm.CustomDir == "" {
+		return Manifest{}, errors.New("custom templates are disabled")
+	}
+	// the owner becomes a directory name
+	if owner == "" || filepath.Base(owner) != owner || strings.HasPrefix(owner, ".") {
+		return Manifest{}, errorpkg.NewErrBadRequest(errors.New(fmt.Sprintf("invalid template owner %q", owner)))
+	}
+	if strings.TrimSpace(displayName) == "" {
+		return Manifest{}, errorpkg.NewErrBadRequest(errors.New("template name is empty"))
+	}
+	if tm.countOwned(owner) >= maxCustomTemplates {
+		return Manifest{}, errorpkg.NewErrBadRequest(errors.New(fmt.Sprintf("at most %d custom templates can be stored", maxCustomTemplates)))
+	}
+
+	files, err := readCustomArchive(archive)
+	if err != nil {
+		return Manifest{}, errorpkg.NewErrBadRequest(err)
+	}
+
+	entry, err := customEntry(files)
+	if err != nil {
+		return Manifest{}, errorpkg.NewErrBadRequest(err)
+	}
+
+	if err := validateCustom(files, entry, tm.sample); err != nil {
+		return Manifest{}, err
+	}
+
+	manifest, err := writeCustom(filepath.Join(tm.CustomDir, owner, uuid.NewString()), files, Manifest{
+		DisplayName:  strings.TrimSpace(displayName),
+		Description:  strings.TrimSpace(description),
+		Languages:    lang.SupportedLanguages(),
+		PaperSizes:   []string{models.PaperA4, models.PaperA5, models.PaperLetter, models.PaperLegal},
+		Orientations: []string{models.OrientationPortrait, models.OrientationLandscape},
+		Fonts:        []string{fonts.Fallback},
+		BaseFontSize: defaultBaseFontSize,
+		Entry:        entry,
+	})
+	if err != nil {
+		return Manifest{}, err
+	}
+	manifest.Owner = owner
+
+	tm.mu.Lock()
+	tm.manifests[manifest.Name] = manifest
+	tm.mu.Unlock()
+
+	return manifest, nil
+}
+
+func (tm *Manager) countOwned(owner string) int {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	count := 0
+	for _, manifest := range tm.manifests {
+		if manifest.Owner == owner {
+			count++
+		}
+	}
+	return count
+}
+
+func readCustomArchive(archive []byte) (map[string][]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid ZIP archive")
+	}
+
+	files := make(map[string][]byte)
+	total := 0
+	for _, f := range zr.File {
+		name := path.Base(f.Name)
+		// archives made on macOS carry resource forks next to the files
+		if f.FileInfo().IsDir() || strings.HasPrefix(f.Name, "__MACOSX/") || strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		// partials are referenced by their file name, folders only come from zipping the template directory
+		if ext := path.Ext(name); ext != ".gohtml" && ext != ".css" {
+			return nil, errors.New(fmt.Sprintf("%s: only .gohtml and .css files are allowed", f.Name))
+		}
+		if _, ok := files[name]; ok {
+			return nil, errors.New(fmt.Sprintf("%s: more than one file is named %s", f.Name, name))
+		}
+		if len(files) == maxCustomFiles {
+			return nil, errors.New(fmt.Sprintf("the archive holds more than %d files", maxCustomFiles))
+		}
+		if f.UncompressedSize64 > maxCustomFileSize {
+			return nil, errors.New(fmt.Sprintf("%s: file exceeds %d bytes", f.Name, maxCustomFileSize))
+		}
+
+		data, err := readCustomFile(f)
+		if err != nil {
+			return nil, errors.Wrap(err, f.Name)
+		}
+		total += len(data)
+		if total > maxCustomSize {
+			return nil, errors.New(fmt.Sprintf("the template exceeds %d bytes", maxCustomSize))
+		}
+
+		files[name] = data
+	}
+
+	if len(files) == 0 {
+		return nil, errors.New("the archive does not contain any template file")
+	}
+
+	return files, nil
+}
+
+func readCustomFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	// the header size can lie, the reader is bounded as well
+	data, err := io.ReadAll(io.LimitReader(rc, maxCustomFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxCustomFileSize {
+		return nil, errors.New(fmt.Sprintf("file exceeds %d bytes", maxCustomFileSize))
+	}
+	if !utf8.Valid(data) {
+		return nil, errors.New("file is not UTF-8 text")
+	}
+
+	return data, nil
+}
+
+func customEntry(files map[string][]byte) (string, error) {
+	var entries []string
+	for name := range files {
+		if strings.HasPrefix(name, "_") && path.Ext(name) == ".gohtml" {
+			entries = append(entries, name)
+		}
+	}
+	if len(entries) != 1 {
+		return "", errors.New(fmt.Sprintf("the template needs exactly one entry file named _<name>.gohtml, found %d", len(entries)))
+	}
+
+	return entries[0], nil
+}
+
+// validateCustom parses the files with the functions of the built-in templates and executes
+// them against the sample. Problems are reported per file in an ErrValidation.
+func validateCustom(files map[string][]byte, entry string, sample models.Resume) error {
+	errValidation := errorpkg.NewErrValidation()
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if reason := sourceIssue(name, string(files[name])); reason != "" {
+			errValidation.Errors[name] = reason
+		}
+	}
+
+	t := template.New(entry).Funcs(Manifest{BaseFontSize: defaultBaseFontSize}.funcs())
+	if len(errValidation.Errors) == 0 {
+		for _, name := range names {
+			tmpl := t
+			if name != entry {
+				tmpl = t.New(name)
+			}
+			if _, err := tmpl.Parse(string(files[name])); err != nil {
+				errValidation.Errors[name] = err.Error()
+			}
+		}
+	}
+	if len(errValidation.Errors) == 0 {
+		if err := boundSteps(t); err != nil {
+			return err
+		}
+	}
+
+	if len(errValidation.Errors) == 0 {
+		rendered, err := execute(t, sample)
+		if err != nil {
+			errValidation.Errors[entry] = err.Error()
+		} else if reason := renderedIssue(rendered); reason != "" {
+			errValidation.Errors[entry] = "renders " + reason
+		}
+	}
+
+	if len(errValidation.Errors) == 0 {
+		return nil
+	}
+
+	errValidation.Err = errors.New(fmt.Sprintf("template is invalid: %d errors", len(errValidation.Errors)))
+	return errValidation
+}
+
+// execute renders the sample, the output is bounded and a panic is returned as an error.
+func execute(t *template.Template, sample models.Resume) (rendered string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.New(fmt.Sprintf("execution failed: %v", r))
+		}
+	}()
+
+	out := &limitedBuffer{max: maxRenderedSize}
+	if err := t.Execute(out, sample); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
+// sourceIssue reports elements and URLs that would make a template file reach the network.
+// Character references and CSS escapes are decoded first since the browser decodes them,
+// the markup and stylesheets are then tokenized to find what they load.
+func sourceIssue(name, content string) string {
+	unescaped := html.UnescapeString(content)
+	for _, text := range []string{content, unescaped, cssUnescape(unescaped)} {
+		if match := forbiddenTagRe.FindStringSubmatch(text); match != nil {
+			return fmt.Sprintf("<%s> elements are not allowed", strings.ToLower(match[1]))
+		}
+		if match := networkURLRe.FindString(anchorHrefRe.ReplaceAllString(text, `$1""`)); match != "" {
+			return fmt.Sprintf("external network resources are not allowed, found %q", strings.TrimSpace(match))
+		}
+		if reason := resourceIssue(text, true); reason != "" {
+			return reason
+		}
+	}
+
+	if path.Ext(name) == ".css" {
+		return cssIssue(content, true)
+	}
+	return markupIssue(content, true)
+}
+
+// renderedIssue reports resources loaded by the HTML a template rendered, values built
+// by template actions are only known at this point.
+func renderedIssue(rendered string) string {
+	if match := forbiddenTagRe.FindStringSubmatch(rendered); match != nil {
+		return fmt.Sprintf("<%s> elements", strings.ToLower(match[1]))
+	}
+	if reason := resourceIssue(rendered, false); reason != "" {
+		return reason
+	}
+	return markupIssue(rendered, false)
+}
+
+// resourceIssue looks for the resources of text without tokenizing it, see valueIssue for
+// the values accepted.
+func resourceIssue(text string, source bool) string {
+	for _, match := range resourceRe.FindAllStringSubmatch(text, -1) {
+		if valueIssue(strings.Join(match[1:], ""), source) != "" {
+			return fmt.Sprintf("resources must be embedded as data URIs, found %q", match[0])
+		}
+	}
+	return ""
+}
+
+func writeCustom(dir string, files map[string][]byte, manifest Manifest) (Manifest, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return Manifest{}, errors.Wrap(err, "writeCustom MkdirAll")
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return Manifest{}, errors.Wrap(err, "writeCustom MarshalIndent")
+	}
+	files[manifestFile] = data
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			os.RemoveAll(dir)
+			return Manifest{}, errors.Wrap(err, "writeCustom WriteFile")
+		}
+	}
+
+	stored, err := readManifest(dir, CustomPrefix+filepath.Base(dir))
+	if err != nil {
+		os.RemoveAll(dir)
+		return Manifest{}, err
+	}
+
+	return stored, nil
+}
+
+// limitedBuffer fails writes beyond max bytes.
+type limitedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.max {
+		return 0, errors.New(fmt.Sprintf("rendered HTML exceeds %d bytes", b.max))
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,269 @@
+package template_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
+)
+
+const (
+	templateDir = "../../../ui"
+	exampleFile = "../../../examples/example.resume.json"
+)
+
+// customArchive zips files, keyed by their name in the archive.
+func customArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func customManager(t *testing.T) *template.Manager {
+	t.Helper()
+
+	templateManager, err := template.NewTemplateManager(templateDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := templateManager.EnableCustomTemplates(t.TempDir(), exampleFile); err != nil {
+		t.Fatal(err)
+	}
+
+	return templateManager
+}
+
+// TestCustomTemplateNameFits checks the names of custom templates against the template
+// columns of resume-service, a longer name fails the insert after the upload.
+func TestCustomTemplateNameFits(t *testing.T) {
+	templateManager := customManager(t)
+
+	manifest, err := templateManager.AddCustomTemplate("1b4e28ba-2fa1-11d2-883f-0016d3cca427", "Mine", "", customArchive(t, map[string]string{
+		"_mine.gohtml": `<html><body><h1>{{ .Basics.Name }}</h1></body></html>`,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(manifest.Name, template.CustomPrefix) {
+		t.Errorf("custom template is named %q, want the %q prefix", manifest.Name, template.CustomPrefix)
+	}
+	if len(manifest.Name) > template.MaxNameLen {
+		t.Errorf("custom template name %q is %d characters, the column holds %d", manifest.Name, len(manifest.Name), template.MaxNameLen)
+	}
+	for _, builtin := range templateManager.Manifests("") {
+		if len(builtin.Name) > template.MaxNameLen {
+			t.Errorf("template name %q is %d characters, the column holds %d", builtin.Name, len(builtin.Name), template.MaxNameLen)
+		}
+	}
+}
+
+// TestCustomTemplateSandbox uploads templates hiding network requests behind the escapes
+// of HTML and CSS, every one must be refused before it is stored.
+func TestCustomTemplateSandbox(t *testing.T) {
+	const page = `<html><body><h1>{{ .Basics.Name }}</h1>%s</body></html>`
+
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "escaped url function",
+			files:   map[string]string{"_mine.gohtml": `<style>body{background:u\72l(h\74tp://169.254.169.254/x)}</style>`},
+			wantErr: true,
+		},
+		{
+			name:    "escaped image-set string",
+			files:   map[string]string{"_mine.gohtml": `<style>body{background:image-set("h\74tp://evil.example/x.png" 1x)}</style>`},
+			wantErr: true,
+		},
+		{
+			name:    "escaped protocol-relative url in a stylesheet",
+			files:   map[string]string{"_mine.gohtml": `<style>{{ template "style.css" }}</style>`, "style.css": `body{background:\75rl(\2f/evil.example/x)}`},
+			wantErr: true,
+		},
+		{
+			name:    "upper case url with a quoted escaped string",
+			files:   map[string]string{"_mine.gohtml": `<style>body{background:URL( "h\74tp://evil.example/x" )}</style>`},
+			wantErr: true,
+		},
+		{
+			name:    "escaped import",
+			files:   map[string]string{"_mine.gohtml": `<style>@\69mport "\2f/evil.example/x.css";</style>`},
+			wantErr: true,
+		},
+		{
+			name:    "character reference for the backslash in a style attribute",
+			files:   map[string]string{"_mine.gohtml": `<p style="background:u&#x5c;72l(h&#x5c;74tp://evil.example/x)">hi</p>`},
+			wantErr: true,
+		},
+		{
+			name:    "character reference inside an SVG style element",
+			files:   map[string]string{"_mine.gohtml": `<svg><style>rect{fill:u&#114;l(h&#116;tp://evil.example/x.svg#p)}</style></svg>`},
+			wantErr: true,
+		},
+		{
+			name:    "escaped url in an SVG paint",
+			files:   map[string]string{"_mine.gohtml": `<svg><rect fill="u\72l(\2f/evil.example/x.svg#p)"/></svg>`},
+			wantErr: true,
+		},
+		{
+			name:    "relative url",
+			files:   map[string]string{"_mine.gohtml": `<style>body{background:url(../../etc/passwd)}</style>`},
+			wantErr: true,
+		},
+		{
+			name:    "second srcset candidate",
+			files:   map[string]string{"_mine.gohtml": `<img srcset="data:image/gif;base64,R0lGODlhAQABAAAAACw= 1x, //evil.example/x.png 2x">`},
+			wantErr: true,
+		},
+		{
+			name:    "protocol-relative url after an action",
+			files:   map[string]string{"_mine.gohtml": `<img src="{{ if .Basics.Name }}data:,{{ else }}//evil.example/x.png{{ end }}">`},
+			wantErr: true,
+		},
+		{
+			name:  "embedded resources",
+			files: map[string]string{"_mine.gohtml": `<style>body{background:u\72l("data:image/gif;base64,R0lGODlhAQABAAAAACw=")}h1{background:image-set("data:image/gif;base64,R0lGODlhAQABAAAAACw=" 1x)}</style><img srcset="data:image/gif;base64,R0lGODlhAQABAAAAACw= 1x"><svg><use href="#icon"/></svg>`},
+		},
+		{
+			name:  "links and photo",
+			files: map[string]string{"_mine.gohtml": `<a href="https://example.com">site</a>{{ with .Basics.Image }}<img src="{{ . }}">{{ end }}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := make(map[string]string, len(tt.files))
+			for name, content := range tt.files {
+				if strings.HasPrefix(name, "_") {
+					content = strings.Replace(page, "%s", content, 1)
+				}
+				files[name] = content
+			}
+
+			_, err := customManager(t).AddCustomTemplate("1b4e28ba-2fa1-11d2-883f-0016d3cca427", "Mine", "", customArchive(t, files))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddCustomTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var errValidation *errorpkg.ErrValidation
+			if tt.wantErr && !errors.As(err, &errValidation) {
+				t.Errorf("AddCustomTemplate() error = %v, want an ErrValidation", err)
+			}
+		})
+	}
+}
+
+// TestCustomTemplateBoundsSteps uploads templates whose loops and template calls never end
+// in practice, they must fail quickly at upload and when a stored one is rendered.
+func TestCustomTemplateBoundsSteps(t *testing.T) {
+	const owner = "1b4e28ba-2fa1-11d2-883f-0016d3cca427"
+
+	// every template calls the next one twice, 2^40 calls without a loop
+	var calls strings.Builder
+	for i := 0; i < 40; i++ {
+		fmt.Fprintf(&calls, `{{ define "t%d" }}{{ template "t%d" }}{{ template "t%d" }}{{ end }}`, i, i+1, i+1)
+	}
+	calls.WriteString(`{{ define "t40" }}{{ end }}{{ template "t0" }}`)
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{name: "nested integer ranges", body: `{{range 100000}}{{range 100000}}{{end}}{{end}}`, wantErr: true},
+		{name: "integer range in a variable", body: `{{ $n := 100000 }}{{ range $n }}{{ range $n }}{{ end }}{{ end }}`, wantErr: true},
+		{name: "integer range of a function", body: `{{ range len (printf "%0999999d" 0) }}{{ range len (printf "%0999999d" 0) }}{{ end }}{{ end }}`, wantErr: true},
+		{name: "exponential template calls", body: calls.String(), wantErr: true},
+		{name: "nested data ranges", body: `{{ range .Work }}<h2>{{ .Company }}</h2>{{ range .Highlights }}<p>{{ . }}</p>{{ end }}{{ end }}{{ range $i, $skill := .Skills }}{{ range $i }}*{{ end }}{{ end }}`},
+		{name: "partials", body: `{{ define "name" }}{{ .Name }}{{ end }}{{ template "name" .Basics }}{{ range .Skills }}{{ template "name" . }}{{ end }}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templateManager := customManager(t)
+			archive := customArchive(t, map[string]string{"_mine.gohtml": `<html><body>` + tt.body + `</body></html>`})
+
+			err := withinDeadline(t, func() error {
+				_, err := templateManager.AddCustomTemplate(owner, "Mine", "", archive)
+				return err
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddCustomTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), "steps") {
+				var errValidation *errorpkg.ErrValidation
+				if !errors.As(err, &errValidation) || !strings.Contains(errValidation.Errors["_mine.gohtml"], "steps") {
+					t.Errorf("AddCustomTemplate() error = %v, want the steps exceeded", err)
+				}
+			}
+		})
+	}
+
+	// a template stored before the upload checked it is bounded when it is rendered
+	templateManager := customManager(t)
+	manifest, err := templateManager.AddCustomTemplate(owner, "Mine", "", customArchive(t, map[string]string{
+		"_mine.gohtml": `<html><body>{{ .Basics.Name }}</body></html>`,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := filepath.Join(templateManager.CustomDir, owner, strings.TrimPrefix(manifest.Name, template.CustomPrefix), manifest.Entry)
+	if err := os.WriteFile(entry, []byte(`{{range 100000}}{{range 100000}}{{end}}{{end}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	err = withinDeadline(t, func() error {
+		tmpl, err := templateManager.GetTemplate(manifest.Name)
+		if err != nil {
+			return err
+		}
+		return tmpl.Execute(io.Discard, models.Resume{})
+	})
+	if err == nil || !strings.Contains(err.Error(), "steps") {
+		t.Errorf("rendering the stored template = %v, want the steps exceeded", err)
+	}
+}
+
+// withinDeadline runs fn and fails the test when it does not return in time.
+func withinDeadline(t *testing.T, fn func() error) error {
+	t.Helper()
+
+	done := make(chan error, 1)
+	go func() { done <- fn() }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(10 * time.Second):
+		t.Fatal("the template did not finish within 10 seconds")
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/lang"
+)
+
+// Message IDs of the locale files used to write dates.
+const (
+	presentLabel        = "PresentLabel"
+	monthYearFormat     = "MonthYearFormat"
+	dayMonthYearFormat  = "DayMonthYearFormat"
+	yearsDuration       = "YearsDuration"
+	monthsDuration      = "MonthsDuration"
+	yearsMonthsDuration = "YearsMonthsDuration"
+	draftStampFormat    = "DraftStampFormat"
+)
+
+// monthLabels are the message IDs of the month names, January first.
+var monthLabels = []string{
+	"JanuaryLabel", "FebruaryLabel", "MarchLabel", "AprilLabel", "MayLabel", "JuneLabel",
+	"JulyLabel", "AugustLabel", "SeptemberLabel", "OctoberLabel", "NovemberLabel", "DecemberLabel",
+}
+
+// now is the end of the periods still running, replaced in tests.
+var now = time.Now
+
+// precision tells how much of a resume date was given.
+type precision int
+
+const (
+	yearPrecision precision = iota + 1
+	monthPrecision
+	dayPrecision
+)
+
+// datePrecisions are the layouts resume dates are written in, ISO 8601 cut after the
+// year, the month or the day.
+var datePrecisions = []struct {
+	layout    string
+	precision precision
+}{
+	{layout: "2006-01-02", precision: dayPrecision},
+	{layout: "2006-01", precision: monthPrecision},
+	{layout: "2006", precision: yearPrecision},
+}
+
+// parseDate reads a YYYY, YYYY-MM or YYYY-MM-DD date.
+func parseDate(date string) (time.Time, precision, bool) {
+	date = strings.TrimSpace(date)
+	for _, p := range datePrecisions {
+		if t, err := time.Parse(p.layout, date); err == nil {
+			return t, p.precision, true
+		}
+	}
+	return time.Time{}, 0, false
+}
+
+// formatDate writes a resume date in the language with the month name, dates in
+// another format are left as the user typed them.
+func formatDate(language, date string) string {
+	t, precision, ok := parseDate(date)
+	if !ok {
+		return date
+	}
+
+	switch precision {
+	case dayPrecision:
+		return lang.TranslateData(language, dayMonthYearFormat, dateData(language, t))
+	case monthPrecision:
+		return lang.TranslateData(language, monthYearFormat, dateData(language, t))
+	}
+	return fmt.Sprint(t.Year())
+}
+
+// dateData fills the placeholders of the date formats.
+func dateData(language string, t time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"Year":        t.Year(),
+		"Month":       lang.Translate(language, monthLabels[t.Month()-1]),
+		"MonthNumber": fmt.Sprintf("%02d", int(t.Month())),
+		"Day":         t.Day(),
+		"DayNumber":   fmt.Sprintf("%02d", t.Day()),
+	}
+}
+
+// DraftStamp tells in the language when a draft was generated, the time is given in UTC.
+func DraftStamp(language string, at time.Time) string {
+	at = at.UTC()
+	return lang.TranslateData(language, draftStampFormat, map[string]interface{}{
+		"Date": lang.TranslateData(language, dayMonthYearFormat, dateData(language, at)),
+		"Time": at.Format("15:04") + " UTC",
+	})
+}
+
+// endDate is formatDate for the end of a period, a period without end is still running.
+func endDate(language, date string) string {
+	if strings.TrimSpace(date) == "" {
+		return lang.Translate(language, presentLabel)
+	}
+	return formatDate(language, date)
+}
+
+// dateRange writes a period such as "March 2019 – Present".
+func dateRange(language, start, end string) string {
+	if strings.TrimSpace(start) == "" {
+		return formatDate(language, end)
+	}
+	return formatDate(language, start) + " – " + endDate(language, end)
+}
+
+// duration writes how long a period lasted in years and months, such as "2 yrs 3 mos".
+// Both months count, a period from January to March lasts 3 months. A date given
+// without month starts in January and ends in December. It is empty when a date cannot
+// be read or the period ends before it starts.
+func duration(language, start, end string) string {
+	from, _, ok := parseDate(start)
+	if !ok {
+		return ""
+	}
+	to := now()
+	if strings.TrimSpace(end) != "" {
+		var precision precision
+		if to, precision, ok = parseDate(end); !ok {
+			return ""
+		}
+		if precision == yearPrecision {
+			to = to.AddDate(0, 11, 0)
+		}
+	}
+
+	months := (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month()) + 1
+	if months <= 0 {
+		return ""
+	}
+
+	years, months := months/12, months%12
+	switch {
+	case years == 0:
+		return lang.TranslatePlural(language, monthsDuration, months)
+	case months == 0:
+		return lang.TranslatePlural(language, yearsDuration, years)
+	}
+	return lang.TranslateData(language, yearsMonthsDuration, map[string]interface{}{
+		"Years":  lang.TranslatePlural(language, yearsDuration, years),
+		"Months": lang.TranslatePlural(language, monthsDuration, months),
+	})
+}
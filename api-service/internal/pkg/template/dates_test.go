@@ -0,0 +1,135 @@
+package template
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		date      string
+		want      time.Time
+		precision precision
+		ok        bool
+	}{
+		{date: "2020", want: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), precision: yearPrecision, ok: true},
+		{date: "2020-03", want: time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC), precision: monthPrecision, ok: true},
+		{date: " 2020-03-15 ", want: time.Date(2020, time.March, 15, 0, 0, 0, 0, time.UTC), precision: dayPrecision, ok: true},
+		{date: "03/2020"},
+		{date: "2020-13"},
+		{date: ""},
+	}
+
+	for _, tt := range tests {
+		got, precision, ok := parseDate(tt.date)
+		if ok != tt.ok || !got.Equal(tt.want) || precision != tt.precision {
+			t.Errorf("parseDate(%q) = %v, %v, %v, want %v, %v, %v", tt.date, got, precision, ok, tt.want, tt.precision, tt.ok)
+		}
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	tests := []struct {
+		language string
+		date     string
+		want     string
+	}{
+		{language: "en", date: "2020-03", want: "March 2020"},
+		{language: "en", date: "2020-03-05", want: "March 5, 2020"},
+		{language: "en", date: "2020", want: "2020"},
+		{language: "ru", date: "2020-03", want: "март 2020"},
+		{language: "ru", date: "2020-03-05", want: "05.03.2020"},
+		{language: "ar", date: "2020-03", want: "مارس 2020"},
+		// dates the user wrote freely are kept
+		{language: "en", date: "Spring 2020", want: "Spring 2020"},
+	}
+
+	for _, tt := range tests {
+		if got := formatDate(tt.language, tt.date); got != tt.want {
+			t.Errorf("formatDate(%q, %q) = %q, want %q", tt.language, tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	tests := []struct {
+		language   string
+		start, end string
+		want       string
+	}{
+		{language: "en", start: "2019-03", end: "2021-07", want: "March 2019 – July 2021"},
+		{language: "en", start: "2019-03", want: "March 2019 – Present"},
+		{language: "ru", start: "2019-03", want: "март 2019 – настоящее время"},
+		{language: "ar", start: "2019-03", want: "مارس 2019 – حتى الآن"},
+		{language: "en", end: "2021", want: "2021"},
+	}
+
+	for _, tt := range tests {
+		if got := dateRange(tt.language, tt.start, tt.end); got != tt.want {
+			t.Errorf("dateRange(%q, %q, %q) = %q, want %q", tt.language, tt.start, tt.end, got, tt.want)
+		}
+	}
+}
+
+func TestDuration(t *testing.T) {
+	defer SetNow(time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC))()
+
+	tests := []struct {
+		name       string
+		language   string
+		start, end string
+		want       string
+	}{
+		{name: "both months count", language: "en", start: "2024-01", end: "2024-03", want: "3 mos"},
+		{name: "a single month", language: "en", start: "2024-03", end: "2024-03", want: "1 mo"},
+		{name: "a full year", language: "en", start: "2023-01", end: "2023-12", want: "1 yr"},
+		{name: "days are ignored", language: "en", start: "2023-01-31", end: "2023-02-01", want: "2 mos"},
+		{name: "years and months", language: "en", start: "2021-02", end: "2023-03", want: "2 yrs 2 mos"},
+		{name: "year-only end runs to December", language: "en", start: "2020-03", end: "2020", want: "10 mos"},
+		{name: "year-only dates", language: "en", start: "2020", end: "2021", want: "2 yrs"},
+		{name: "running period", language: "en", start: "2022-03", want: "2 yrs 4 mos"},
+		{name: "end before start", language: "en", start: "2024-05", end: "2024-03"},
+		{name: "unreadable start", language: "en", start: "soon", end: "2024"},
+		{name: "unreadable end", language: "en", start: "2024", end: "later"},
+		{name: "no start", language: "en", end: "2024"},
+
+		{name: "ru one", language: "ru", start: "2020-01", end: "2020-12", want: "1 г."},
+		{name: "ru few", language: "ru", start: "2019-01", end: "2020-12", want: "2 г."},
+		{name: "ru many", language: "ru", start: "2015-01", end: "2019-12", want: "5 л."},
+		{name: "ru twenty one", language: "ru", start: "2000-01", end: "2020-12", want: "21 г."},
+		{name: "ru months", language: "ru", start: "2020-01", end: "2020-05", want: "5 мес."},
+
+		{name: "ar one", language: "ar", start: "2020-01", end: "2020-12", want: "سنة"},
+		{name: "ar two", language: "ar", start: "2019-01", end: "2020-12", want: "سنتان"},
+		{name: "ar few", language: "ar", start: "2018-01", end: "2020-12", want: "3 سنوات"},
+		{name: "ar many months", language: "ar", start: "2020-01", end: "2020-11", want: "11 شهرًا"},
+		{name: "ar years and months", language: "ar", start: "2019-01", end: "2021-02", want: "سنتان وشهران"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := duration(tt.language, tt.start, tt.end); got != tt.want {
+				t.Errorf("duration(%q, %q, %q) = %q, want %q", tt.language, tt.start, tt.end, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDraftStamp(t *testing.T) {
+	at := time.Date(2026, time.March, 5, 15, 4, 0, 0, time.FixedZone("UZT", 5*60*60))
+
+	tests := []struct {
+		language string
+		want     string
+	}{
+		{language: "en", want: "Draft generated on March 5, 2026 at 10:04 UTC"},
+		{language: "ru", want: "Черновик создан 05.03.2026 в 10:04 UTC"},
+		{language: "de", want: "Draft generated on March 5, 2026 at 10:04 UTC"},
+	}
+
+	for _, tt := range tests {
+		if got := DraftStamp(tt.language, at); got != tt.want {
+			t.Errorf("DraftStamp(%q) = %q, want %q", tt.language, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package template
+
+import "time"
+
+// SetNow fixes the time periods without end date are measured to, until restore is called.
+func SetNow(t time.Time) (restore func()) {
+	now = func() time.Time { return t }
+	return func() { now = time.Now }
+}
+
+// PageHeight is the pageHeight template function.
+var PageHeight = pageHeight
@@ -0,0 +1,35 @@
+package template
+
+import (
+	"fmt"
+	"html/template"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/fonts"
+	"github.com/pkg/errors"
+)
+
+// fontFaces returns the @font-face rules of the bundled fonts, stylesheets include them
+// instead of importing web fonts.
+func fontFaces() template.CSS {
+	return template.CSS(fonts.FaceRules())
+}
+
+// ValidateGlyphCoverage checks that the fonts the resume is rendered with have a glyph
+// for every letter of its language, Chrome would print a box for a missing one.
+func ValidateGlyphCoverage(manifest Manifest, resumeData models.Resume) error {
+	families := manifest.Fonts
+	if resumeData.Meta.Theme.FontFamily != "" {
+		families = []string{resumeData.Meta.Theme.FontFamily}
+	}
+
+	missing, err := fonts.Missing(resumeData.Meta.Lang, families...)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return errors.New(fmt.Sprintf("the fonts of template %q have no glyph for %q, needed to write in %q", manifest.Name, string(missing), resumeData.Meta.Lang))
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package template_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/fonts"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/parser"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
+)
+
+var cssURLRe = regexp.MustCompile(`url\(\s*['"]?([^'")\s]*)`)
+
+// TestThemeFontsAreBundled renders every template with every font of the theme, the
+// faces must come from the bundled fonts and never from a font service.
+func TestThemeFontsAreBundled(t *testing.T) {
+	templateManager, err := template.NewTemplateManager(templateDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	htmlParser := parser.NewHTMLParser(templateManager)
+
+	for _, manifest := range templateManager.Manifests("") {
+		for _, family := range fonts.Names() {
+			// the stylesheets load the fonts, the content of the resume does not matter
+			resume := models.Resume{Basics: models.Basics{Name: "John Doe"}}
+			resume.Meta.Template = manifest.Name
+			resume.Meta.Theme.FontFamily = family
+
+			html, err := htmlParser.ParseToHtml(resume)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(string(html), "@import") {
+				t.Errorf("%s with %s imports a stylesheet", manifest.Name, family)
+			}
+			for _, match := range cssURLRe.FindAllStringSubmatch(string(html), -1) {
+				if !strings.HasPrefix(match[1], fonts.Origin) && !strings.HasPrefix(match[1], "data:") {
+					t.Errorf("%s with %s loads %s", manifest.Name, family, match[1])
+				}
+			}
+		}
+	}
+}
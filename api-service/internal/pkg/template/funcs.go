@@ -2,7 +2,8 @@ package template
 
 import (
 	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
-	"html/template"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/photo"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/lang"
 	"strings"
 )
 
@@ -55,10 +56,21 @@ func getLastName(name string) string {
 	return name
 }
 
-func evaluate(htmlStr string) template.HTML {
-	return template.HTML(htmlStr)
-}
-
 func lowerEq(s1 string, s2 string) bool {
 	return strings.EqualFold(strings.ToLower(s1), strings.ToLower(s2))
 }
+
+// textDir returns the dir attribute of a document written in the language of meta.lang.
+func textDir(language string) string {
+	return lang.Direction(language)
+}
+
+func isRTL(language string) bool {
+	return lang.Direction(language) == lang.RTL
+}
+
+// photoURL links the photo of the resume in the size printed by the templates, whichever
+// variant of it was chosen.
+func photoURL(url string) string {
+	return photo.VariantURL(url, photo.VariantTemplate)
+}
@@ -0,0 +1,114 @@
+package template_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/parser"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/lang"
+)
+
+// update rewrites the golden files with the current output instead of comparing them,
+// run go test ./internal/pkg/template -update after an intended template change.
+var update = flag.Bool("update", false, "rewrite the golden files with the current output")
+
+const (
+	fixturesDir = "testdata/fixtures"
+	goldenDir   = "testdata/golden"
+)
+
+// goldenNow is the day durations of running periods are measured to, so that the
+// snapshots do not age.
+var goldenNow = time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+// TestGolden renders the example resume and every fixture with every template in every
+// language and compares the HTML with testdata/golden/<fixture>/<template>/<lang>.html.
+func TestGolden(t *testing.T) {
+	defer template.SetNow(goldenNow)()
+
+	templateManager, err := template.NewTemplateManager(templateDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	htmlParser := parser.NewHTMLParser(templateManager)
+
+	fixtures, err := filepath.Glob(filepath.Join(fixturesDir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixtures = append([]string{exampleFile}, fixtures...)
+
+	for _, fixture := range fixtures {
+		data, err := os.ReadFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// examples/example.resume.json is snapshotted as example
+		fixtureName := strings.TrimSuffix(strings.TrimSuffix(filepath.Base(fixture), ".json"), ".resume")
+
+		for _, manifest := range templateManager.Manifests("") {
+			for _, language := range lang.SupportedLanguages() {
+				name := filepath.Join(fixtureName, manifest.Name, language)
+				t.Run(name, func(t *testing.T) {
+					// every run decodes its own copy, templates must not depend on what ran before
+					var resume models.Resume
+					if err := json.Unmarshal(data, &resume); err != nil {
+						t.Fatal(err)
+					}
+					resume.Meta.Template = manifest.Name
+					resume.Meta.Lang = language
+
+					html, err := htmlParser.ParseToHtml(resume)
+					if err != nil {
+						t.Fatal(err)
+					}
+					compareGolden(t, filepath.Join(goldenDir, name+".html"), html)
+				})
+			}
+		}
+	}
+}
+
+func compareGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v, run go test with -update to create it", err)
+	}
+	if bytes.Equal(got, want) {
+		return
+	}
+
+	gotLines, wantLines := strings.Split(string(got), "\n"), strings.Split(string(want), "\n")
+	for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+		var gotLine, wantLine string
+		if i < len(gotLines) {
+			gotLine = gotLines[i]
+		}
+		if i < len(wantLines) {
+			wantLine = wantLines[i]
+		}
+		if gotLine != wantLine {
+			t.Fatalf("output differs from %s at line %d\n got: %q\nwant: %q\nrun go test with -update if the change is intended", path, i+1, gotLine, wantLine)
+		}
+	}
+}
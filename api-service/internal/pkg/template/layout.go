@@ -0,0 +1,52 @@
+package template
+
+import (
+	"fmt"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/pkg/errors"
+)
+
+const cssPixelsPerInch = 96
+
+// ValidatePageLayout checks that the owner may use the template and that it can be
+// printed with the requested layout, as declared in its manifest.
+func (tm *Manager) ValidatePageLayout(name, owner string, layout models.PageLayout) error {
+	manifest, err := tm.Lookup(name, owner)
+	if err != nil {
+		return err
+	}
+	layout = layout.WithDefaults()
+
+	// sidebar and two-column layouts get too narrow on A5 or in landscape
+	if !contains(manifest.PaperSizes, layout.Size) {
+		return errors.New(fmt.Sprintf("template %q does not support paper size %q", manifest.Name, layout.Size))
+	}
+	if !contains(manifest.Orientations, layout.Orientation) {
+		return errors.New(fmt.Sprintf("template %q does not support %s orientation", manifest.Name, layout.Orientation))
+	}
+
+	return nil
+}
+
+// pageHeight returns the printable height of one page in CSS pixels, within the margins
+// the PDF is printed with.
+func pageHeight(layout models.PageLayout) int {
+	_, height, ok := layout.WithDefaults().Dimensions()
+	if !ok {
+		_, height, _ = models.PageLayout{}.WithDefaults().Dimensions()
+	}
+	margins := layout.PrintMargins()
+	height -= margins.Top + margins.Bottom
+
+	return int(height * cssPixelsPerInch)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package template_test
+
+import (
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/template"
+)
+
+func TestValidatePageLayout(t *testing.T) {
+	templateManager, err := template.NewTemplateManager(templateDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		layout   models.PageLayout
+		wantErr  bool
+	}{
+		{name: "defaults", template: models.ClassicTemplate},
+		{name: "default template", layout: models.PageLayout{Size: models.PaperLetter}},
+		{name: "supported size", template: "simple", layout: models.PageLayout{Size: models.PaperA5}},
+		{name: "landscape", template: "simple", layout: models.PageLayout{Orientation: models.OrientationLandscape}},
+		{name: "unsupported size", template: models.ClassicTemplate, layout: models.PageLayout{Size: models.PaperA5}, wantErr: true},
+		{name: "unsupported orientation", template: models.ClassicTemplate, layout: models.PageLayout{Orientation: models.OrientationLandscape}, wantErr: true},
+		{name: "unknown size", template: "simple", layout: models.PageLayout{Size: "A3"}, wantErr: true},
+		{name: "unknown template", template: "fancy", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := templateManager.ValidatePageLayout(tt.template, "", tt.layout); (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePageLayout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPageHeight(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout models.PageLayout
+		want   int
+	}{
+		{name: "defaults", want: 1122},
+		{name: "landscape", layout: models.PageLayout{Orientation: models.OrientationLandscape}, want: 793},
+		{name: "margins", layout: models.PageLayout{Size: models.PaperLetter, Margins: models.PageMargins{Top: 0.5, Bottom: 0.5}}, want: 960},
+		// Chrome prints header, footer and the stamp of drafts in the margins
+		{name: "header", layout: models.PageLayout{Size: models.PaperLetter, Header: "{name}"}, want: 1017},
+		{name: "header and footer", layout: models.PageLayout{Size: models.PaperLetter, Header: "{name}", Footer: "{page}"}, want: 979},
+		{name: "wide margins", layout: models.PageLayout{Size: models.PaperLetter, Footer: "{page}", Margins: models.PageMargins{Bottom: 1}}, want: 960},
+		{name: "draft", layout: models.PageLayout{Size: models.PaperLetter, Draft: true}, want: 1017},
+		{name: "unknown size", layout: models.PageLayout{Size: "A3"}, want: 1122},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := template.PageHeight(tt.layout); got != tt.want {
+				t.Errorf("pageHeight() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
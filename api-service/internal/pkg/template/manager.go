@@ -1,43 +1,136 @@
 package template
 
 import (
+	"fmt"
 	"github.com/pkg/errors"
 	"html/template"
-	"path"
 	"path/filepath"
+	"sync"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/markdown"
 )
 
+// Manager renders the templates found in TemplateDir at startup and the custom
+// templates uploaded by users.
 type Manager struct {
 	TemplateDir string
+	// CustomDir holds the custom templates in <owner>/<id> directories, empty when they are disabled
+	CustomDir string
+	// sample is the resume custom templates are executed against before they are stored
+	sample models.Resume
+
+	mu        sync.RWMutex
+	manifests map[string]Manifest
 }
 
-func NewTemplateManager(templateDir string) *Manager {
+// NewTemplateManager discovers the templates of templateDir and loads their manifests.
+func NewTemplateManager(templateDir string) (*Manager, error) {
+	manifests, err := loadManifests(templateDir, "")
+	if err != nil {
+		return nil, err
+	}
+
 	return &Manager{
 		TemplateDir: templateDir,
-	}
+		manifests:   manifests,
+	}, nil
 }
 
+// GetTemplate parses the template, access to custom templates is checked by Lookup beforehand.
 func (tm *Manager) GetTemplate(name string) (*template.Template, error) {
-	templateFiles, err := filepath.Glob(filepath.Join(tm.TemplateDir, name, "*"))
+	manifest, err := tm.find(name)
 	if err != nil {
-		return nil, errors.Wrap(err, "GetTemplate filepath.Glob")
+		return nil, err
 	}
 
-	templateFuncs := template.FuncMap{
-		"isLast":          isLast,
-		"displayLocation": displayLocation,
-		"trimURLPrefix":   trimURLPrefix,
-		"getFirstName":    getFirstName,
-		"getLastName":     getLastName,
-		"evaluate":        evaluate,
-		"lowerEq":         lowerEq,
+	var templateFiles []string
+	for _, pattern := range templatePatterns {
+		files, err := filepath.Glob(filepath.Join(manifest.dir, pattern))
+		if err != nil {
+			return nil, errors.Wrap(err, "GetTemplate filepath.Glob")
+		}
+		templateFiles = append(templateFiles, files...)
 	}
 
-	t := template.New(path.Base(templateFiles[0])).Funcs(templateFuncs)
-	t, err = t.ParseFiles(templateFiles...)
+	t, err := template.New(manifest.Entry).Funcs(manifest.funcs()).ParseFiles(templateFiles...)
 	if err != nil {
 		return nil, errors.Wrap(err, "GetTemplate template.New")
 	}
+	// custom templates stored before their loops were bounded are bounded at render time too
+	if manifest.Owner != "" {
+		if err := boundSteps(t); err != nil {
+			return nil, err
+		}
+	}
 
 	return t, nil
 }
+
+// GetLetterTemplate parses the template and returns its cover letter layout, it shares
+// the stylesheet and the partials of the resume.
+func (tm *Manager) GetLetterTemplate(name string) (*template.Template, error) {
+	manifest, err := tm.find(name)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Letter == "" {
+		return nil, errorpkg.NewErrBadRequest(errors.New(fmt.Sprintf("template %q has no cover letter layout", manifest.Name)))
+	}
+
+	t, err := tm.GetTemplate(name)
+	if err != nil {
+		return nil, err
+	}
+
+	letter := t.Lookup(manifest.Letter)
+	if letter == nil {
+		return nil, errors.New(fmt.Sprintf("GetLetterTemplate %s - %s is not parsed", manifest.Name, manifest.Letter))
+	}
+
+	return letter, nil
+}
+
+// templatePatterns match the files a template is made of, stylesheets are included with {{ template "name.css" }}.
+var templatePatterns = []string{"*.gohtml", "*.css"}
+
+var templateFuncs = template.FuncMap{
+	"isLast":          isLast,
+	"displayLocation": displayLocation,
+	"trimURLPrefix":   trimURLPrefix,
+	"getFirstName":    getFirstName,
+	"getLastName":     getLastName,
+	"markdown":        markdown.Block,
+	"markdownInline":  markdown.Inline,
+	"lowerEq":         lowerEq,
+	"pageHeight":      pageHeight,
+	"fontFaces":       fontFaces,
+	"textDir":         textDir,
+	"isRTL":           isRTL,
+	"formatDate":      formatDate,
+	"endDate":         endDate,
+	"dateRange":       dateRange,
+	"duration":        duration,
+	"photo":           photoURL,
+
+	// evaluate used to trust its input, custom templates still calling it get markdown
+	"evaluate": markdown.Block,
+}
+
+// funcs adds the functions depending on the manifest to templateFuncs.
+func (m Manifest) funcs() template.FuncMap {
+	funcs := template.FuncMap{
+		"themeStyle": func(theme models.Theme) template.CSS {
+			return themeStyle(theme, m.BaseFontSize)
+		},
+		"sections": m.sections,
+	}
+	for name, fn := range budgetFuncs() {
+		funcs[name] = fn
+	}
+	for name, fn := range templateFuncs {
+		funcs[name] = fn
+	}
+	return funcs
+}
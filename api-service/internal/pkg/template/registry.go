@@ -0,0 +1,231 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	errorpkg "github.com/dostonshernazarov/resume_maker/api-service/internal/errors"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/fonts"
+	"github.com/pkg/errors"
+)
+
+const manifestFile = "manifest.json"
+
+// MaxNameLen is the width of the template columns of resume-service, every template
+// name is stored with the resumes and cover letters rendered with it.
+const MaxNameLen = 64
+
+// Manifest describes a template, it is read from the manifest.json of the template directory.
+type Manifest struct {
+	// Name is what meta.template refers to, the directory of a built-in template
+	Name         string   `json:"-"`
+	DisplayName  string   `json:"display_name"`
+	Description  string   `json:"description"`
+	Sections     []string `json:"sections"`
+	Languages    []string `json:"languages"`
+	PaperSizes   []string `json:"paper_sizes"`
+	Orientations []string `json:"orientations"`
+	// Side lists the sections of the side column of two column templates, the others are in the main column
+	Side []string `json:"side,omitempty"`
+	// Fonts are the bundled families of the stylesheet, meta.theme.fontFamily replaces them
+	Fonts []string `json:"fonts"`
+	// BaseFontSize is the size of body text in pixels, the theme font size is relative to it
+	BaseFontSize float64 `json:"base_font_size"`
+	// Preview is an image file in the template directory
+	Preview string `json:"preview,omitempty"`
+	// Entry is the file executed to render a resume, _<name>.gohtml by default
+	Entry string `json:"entry,omitempty"`
+	// Letter is the file executed to render a cover letter, empty when the template has none
+	Letter string `json:"letter,omitempty"`
+	// Owner is the user who uploaded a custom template, empty for built-in ones
+	Owner string `json:"-"`
+
+	dir string
+}
+
+// loadManifests reads the manifest of every directory of templateDir, names of
+// custom templates get the custom prefix. A directory without a valid manifest
+// stops the service from starting.
+func loadManifests(templateDir, owner string) (map[string]Manifest, error) {
+	entries, err := os.ReadDir(templateDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "loadManifests ReadDir")
+	}
+
+	manifests := make(map[string]Manifest)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		name := entry.Name()
+		if owner != "" {
+			name = CustomPrefix + entry.Name()
+		}
+
+		manifest, err := readManifest(filepath.Join(templateDir, entry.Name()), name)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("template %q", name))
+		}
+		manifest.Owner = owner
+		manifests[manifest.Name] = manifest
+	}
+
+	if len(manifests) == 0 && owner == "" {
+		return nil, errors.New(fmt.Sprintf("no template found in %s", templateDir))
+	}
+
+	return manifests, nil
+}
+
+func readManifest(dir, name string) (Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFile))
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return Manifest{}, errors.Wrap(err, manifestFile)
+	}
+	if len(name) > MaxNameLen {
+		return Manifest{}, errors.New(fmt.Sprintf("template name %q is longer than %d characters", name, MaxNameLen))
+	}
+	manifest.Name = name
+	manifest.dir = dir
+	if manifest.Entry == "" {
+		manifest.Entry = "_" + name + ".gohtml"
+	}
+
+	if manifest.DisplayName == "" {
+		return Manifest{}, errors.New("display_name is empty")
+	}
+	if filepath.Base(manifest.Entry) != manifest.Entry {
+		return Manifest{}, errors.New(fmt.Sprintf("entry %q is not a file of the template directory", manifest.Entry))
+	}
+	if _, err := os.Stat(filepath.Join(dir, manifest.Entry)); err != nil {
+		return Manifest{}, errors.Wrap(err, "entry file")
+	}
+	if manifest.Letter != "" {
+		if filepath.Base(manifest.Letter) != manifest.Letter {
+			return Manifest{}, errors.New(fmt.Sprintf("letter %q is not a file of the template directory", manifest.Letter))
+		}
+		if _, err := os.Stat(filepath.Join(dir, manifest.Letter)); err != nil {
+			return Manifest{}, errors.Wrap(err, "letter file")
+		}
+	}
+	for _, section := range manifest.Sections {
+		if !contains(models.Sections, section) {
+			return Manifest{}, errors.New(fmt.Sprintf("unknown section %q", section))
+		}
+	}
+	for _, section := range manifest.Side {
+		if !contains(manifest.Sections, section) {
+			return Manifest{}, errors.New(fmt.Sprintf("side section %q is not a section of the template", section))
+		}
+	}
+	if len(manifest.Languages) == 0 {
+		return Manifest{}, errors.New("languages are empty")
+	}
+	if len(manifest.PaperSizes) == 0 || len(manifest.Orientations) == 0 {
+		return Manifest{}, errors.New("paper sizes and orientations are required")
+	}
+	for _, size := range manifest.PaperSizes {
+		if _, _, ok := (models.PageLayout{Size: size}).Dimensions(); !ok {
+			return Manifest{}, errors.New(fmt.Sprintf("unknown paper size %q", size))
+		}
+	}
+	for _, orientation := range manifest.Orientations {
+		if orientation != models.OrientationPortrait && orientation != models.OrientationLandscape {
+			return Manifest{}, errors.New(fmt.Sprintf("unknown orientation %q", orientation))
+		}
+	}
+	if len(manifest.Fonts) == 0 {
+		manifest.Fonts = []string{fonts.Fallback}
+	}
+	for _, family := range manifest.Fonts {
+		if _, ok := fonts.Lookup(family); !ok {
+			return Manifest{}, errors.New(fmt.Sprintf("font family %q is not bundled", family))
+		}
+	}
+	if manifest.BaseFontSize <= 0 {
+		return Manifest{}, errors.New("base_font_size must be positive")
+	}
+	if manifest.Preview != "" {
+		// the preview is served as is, it must not point outside the template directory
+		if filepath.Base(manifest.Preview) != manifest.Preview {
+			return Manifest{}, errors.New(fmt.Sprintf("preview %q is not a file of the template directory", manifest.Preview))
+		}
+		if _, err := os.Stat(filepath.Join(dir, manifest.Preview)); err != nil {
+			return Manifest{}, errors.Wrap(err, "preview")
+		}
+	}
+
+	return manifest, nil
+}
+
+// Lookup returns the manifest of a template the owner may use, an empty name is the
+// classic template. Custom templates of other users are reported as unknown, and so
+// is every unknown name, as ErrBadRequest since they come from the resume meta.
+func (tm *Manager) Lookup(name, owner string) (Manifest, error) {
+	manifest, err := tm.find(name)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if manifest.Owner != "" && manifest.Owner != owner {
+		return Manifest{}, errorpkg.NewErrBadRequest(errors.New(fmt.Sprintf("unknown template %q", name)))
+	}
+
+	return manifest, nil
+}
+
+// find returns the manifest of any template, without checking who owns it.
+func (tm *Manager) find(name string) (Manifest, error) {
+	if name == "" {
+		name = models.ClassicTemplate
+	}
+
+	tm.mu.RLock()
+	manifest, ok := tm.manifests[name]
+	tm.mu.RUnlock()
+	if !ok {
+		return Manifest{}, errorpkg.NewErrBadRequest(errors.New(fmt.Sprintf("unknown template %q", name)))
+	}
+
+	return manifest, nil
+}
+
+// Manifests returns the built-in templates and the custom templates of the owner sorted by name.
+func (tm *Manager) Manifests(owner string) []Manifest {
+	tm.mu.RLock()
+	manifests := make([]Manifest, 0, len(tm.manifests))
+	for _, manifest := range tm.manifests {
+		if manifest.Owner == "" || manifest.Owner == owner {
+			manifests = append(manifests, manifest)
+		}
+	}
+	tm.mu.RUnlock()
+
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].Name < manifests[j].Name
+	})
+
+	return manifests
+}
+
+// PreviewPath returns the path of the preview image of a built-in template.
+func (tm *Manager) PreviewPath(name string) (string, error) {
+	manifest, err := tm.Lookup(name, "")
+	if err != nil {
+		return "", err
+	}
+	if manifest.Preview == "" {
+		return "", errorpkg.NewErrNotFound("template preview")
+	}
+
+	return filepath.Join(manifest.dir, manifest.Preview), nil
+}
@@ -0,0 +1,224 @@
+package template
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gorilla/css/scanner"
+	nethtml "golang.org/x/net/html"
+)
+
+var (
+	// resourceAttributes hold a URL the browser loads on its own.
+	resourceAttributes = map[string]bool{"src": true, "srcset": true, "poster": true, "background": true, "data": true, "lowsrc": true, "xlink:href": true}
+	// svgResourceElements load what their href points to, the href of other elements is a link.
+	svgResourceElements = map[string]bool{"image": true, "use": true, "feimage": true}
+	// cssAttributes take CSS values, SVG paints and effects can refer to other documents with url().
+	cssAttributes = map[string]bool{"style": true, "fill": true, "stroke": true, "filter": true, "mask": true, "clip-path": true, "marker-start": true, "marker-mid": true, "marker-end": true, "cursor": true}
+	// resourceFunctions are the CSS functions whose arguments are loaded.
+	resourceFunctions = map[string]bool{"url": true, "src": true, "image": true, "image-set": true, "-webkit-image-set": true, "cross-fade": true, "-webkit-cross-fade": true}
+)
+
+// markupIssue reports the resources an HTML document loads. The markup is tokenized like the
+// browser does, attribute values come with their character references decoded and the
+// stylesheets of style elements and attributes are checked by cssIssue.
+func markupIssue(text string, source bool) string {
+	z := nethtml.NewTokenizer(strings.NewReader(text))
+	for {
+		switch z.Next() {
+		case nethtml.ErrorToken:
+			return ""
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			token := z.Token()
+			for _, attr := range token.Attr {
+				var reason string
+				switch key := strings.ToLower(attr.Key); {
+				case key == "srcset":
+					reason = srcsetIssue(attr.Val, source)
+				case resourceAttributes[key], key == "href" && svgResourceElements[token.Data]:
+					reason = valueIssue(attr.Val, source)
+				case cssAttributes[key]:
+					reason = cssIssue(attr.Val, source)
+				}
+				if reason != "" {
+					return reason
+				}
+			}
+
+			// the content of a style element is raw text, the tokenizer returns it in one piece
+			if token.Data == "style" && token.Type == nethtml.StartTagToken && z.Next() == nethtml.TextToken {
+				content := string(z.Raw())
+				// inside SVG the content is parsed as markup, character references are decoded
+				for _, css := range []string{content, html.UnescapeString(content)} {
+					if reason := cssIssue(css, source); reason != "" {
+						return reason
+					}
+				}
+			}
+		}
+	}
+}
+
+// cssIssue reports imports and resources of a stylesheet. The CSS is tokenized and its
+// escapes are decoded before URLs are looked at, the browser reads u\72l( as url(.
+func cssIssue(css string, source bool) string {
+	var (
+		decoded strings.Builder
+		// functions holds the names of the open functions, a plain parenthesis opens ""
+		functions []string
+		// argument collects the argument of the innermost open url() or src()
+		argument strings.Builder
+		capture  = -1
+	)
+
+	s := scanner.New(css)
+	for {
+		token := s.Next()
+		switch token.Type {
+		case scanner.TokenEOF:
+			// what could not be tokenized is looked at as well, the browser may read it differently
+			if match := networkURLRe.FindString(decoded.String()); match != "" {
+				return fmt.Sprintf("external network resources are not allowed, found %q", strings.TrimSpace(match))
+			}
+			return ""
+		case scanner.TokenError:
+			return fmt.Sprintf("the stylesheet cannot be read at line %d, column %d", token.Line, token.Column)
+		case scanner.TokenComment:
+			continue
+		}
+
+		value := cssUnescape(token.Value)
+		decoded.WriteString(value)
+
+		switch {
+		case token.Type == scanner.TokenAtKeyword && strings.EqualFold(value, "@import"):
+			return "stylesheet imports are not allowed"
+		case token.Type == scanner.TokenURI:
+			if reason := valueIssue(trimQuotes(value[len("url("):len(value)-1]), source); reason != "" {
+				return reason
+			}
+		case token.Type == scanner.TokenFunction:
+			name := strings.ToLower(strings.TrimSuffix(value, "("))
+			if capture < 0 && (name == "url" || name == "src") {
+				capture = len(functions)
+				argument.Reset()
+			}
+			functions = append(functions, name)
+		case token.Type == scanner.TokenChar && value == "(":
+			functions = append(functions, "")
+		case token.Type == scanner.TokenChar && value == ")" && len(functions) > 0:
+			functions = functions[:len(functions)-1]
+			if capture == len(functions) {
+				capture = -1
+				if reason := valueIssue(trimQuotes(argument.String()), source); reason != "" {
+					return reason
+				}
+			}
+		case capture >= 0:
+			argument.WriteString(value)
+		case token.Type == scanner.TokenString && len(functions) > 0 && resourceFunctions[functions[len(functions)-1]]:
+			// image-set() and friends take URLs as plain strings
+			if reason := valueIssue(trimQuotes(value), source); reason != "" {
+				return reason
+			}
+		}
+	}
+}
+
+// srcsetIssue checks every candidate of a srcset. A URL runs up to the next white space,
+// commas inside it such as the one of a data URI do not separate candidates.
+func srcsetIssue(srcset string, source bool) string {
+	rest := srcset
+	for {
+		rest = strings.TrimLeft(rest, " \t\n\r\f,")
+		if rest == "" {
+			return ""
+		}
+
+		end := strings.IndexAny(rest, " \t\n\r\f")
+		if end < 0 {
+			end = len(rest)
+		}
+		url := rest[:end]
+		rest = rest[end:]
+		if strings.HasSuffix(url, ",") {
+			url = strings.TrimRight(url, ",")
+		} else if comma := strings.IndexByte(rest, ','); comma >= 0 {
+			// the descriptors of the candidate
+			rest = rest[comma+1:]
+		} else {
+			rest = ""
+		}
+
+		if reason := valueIssue(url, source); reason != "" {
+			return reason
+		}
+	}
+}
+
+// valueIssue accepts embedded data, fragments and, in template sources, values set by actions.
+func valueIssue(value string, source bool) string {
+	value = strings.TrimSpace(value)
+	switch {
+	case value == "", strings.HasPrefix(value, "#"), strings.HasPrefix(strings.ToLower(value), "data:"):
+	case source && strings.HasPrefix(value, "{{"):
+	default:
+		return fmt.Sprintf("resources must be embedded as data URIs, found %q", value)
+	}
+	return ""
+}
+
+func trimQuotes(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// cssUnescape decodes the escapes of CSS, a backslash followed by up to six hexadecimal digits
+// and an optional white space, a backslash before a newline or before any other character.
+func cssUnescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+
+		i++
+		j := i
+		for j < len(s) && j-i < 6 && strings.IndexByte("0123456789abcdefABCDEF", s[j]) >= 0 {
+			j++
+		}
+		switch {
+		case j > i:
+			code, _ := strconv.ParseUint(s[i:j], 16, 32)
+			r := rune(code)
+			if r == 0 || !utf8.ValidRune(r) {
+				r = utf8.RuneError
+			}
+			b.WriteRune(r)
+			i = j
+			if i < len(s) && strings.IndexByte(" \t\n\r\f", s[i]) >= 0 {
+				i++
+			}
+		case i < len(s) && s[i] == '\n':
+			i++
+		case i < len(s):
+			_, size := utf8.DecodeRuneInString(s[i:])
+			b.WriteString(s[i : i+size])
+			i += size
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,99 @@
+package template
+
+import (
+	"fmt"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/pkg/errors"
+)
+
+// Section is what section.gohtml of a template renders, the templates range over
+// the sections of a column and dispatch on the name.
+type Section struct {
+	Name   string
+	Resume models.Resume
+}
+
+// ValidateSectionLayout checks that the layout only refers to known sections, once each.
+// Sections a template does not render are accepted, the resume may switch templates later.
+func ValidateSectionLayout(layout models.SectionLayout) error {
+	ordered := make(map[string]bool, len(layout.Order))
+	for _, name := range layout.Order {
+		if err := checkLayoutSection(name); err != nil {
+			return err
+		}
+		if ordered[name] {
+			return errors.New(fmt.Sprintf("section %q is ordered twice", name))
+		}
+		ordered[name] = true
+	}
+	for _, name := range layout.Hidden {
+		if err := checkLayoutSection(name); err != nil {
+			return err
+		}
+	}
+	for name, column := range layout.Columns {
+		if err := checkLayoutSection(name); err != nil {
+			return err
+		}
+		if column != models.ColumnMain && column != models.ColumnSide {
+			return errors.New(fmt.Sprintf("unknown column %q, use %s or %s", column, models.ColumnMain, models.ColumnSide))
+		}
+	}
+
+	return nil
+}
+
+func checkLayoutSection(name string) error {
+	if name == models.SectionBasics {
+		return errors.New("basics always stay on top, they cannot be moved or hidden")
+	}
+	if !contains(models.Sections, name) {
+		return errors.New(fmt.Sprintf("unknown section %q", name))
+	}
+	return nil
+}
+
+// sections returns the visible sections of a column in the order of the layout. The
+// column is empty for single column templates, their layout columns are ignored.
+func (m Manifest) sections(resume models.Resume, column string) []Section {
+	available := m.Sections
+	if len(available) == 0 {
+		// custom templates render whatever they want
+		available = models.Sections
+	}
+
+	layout := resume.Meta.Layout
+	order := make([]string, 0, len(available))
+	for _, name := range append(append([]string{}, layout.Order...), available...) {
+		if name == models.SectionBasics || !contains(available, name) || contains(order, name) {
+			continue
+		}
+		order = append(order, name)
+	}
+
+	var res []Section
+	for _, name := range order {
+		if contains(layout.Hidden, name) {
+			continue
+		}
+		if column != "" && m.column(name, layout) != column {
+			continue
+		}
+		res = append(res, Section{Name: name, Resume: resume})
+	}
+
+	return res
+}
+
+// column returns where a section goes in a two column template.
+func (m Manifest) column(name string, layout models.SectionLayout) string {
+	switch layout.Columns[name] {
+	case models.ColumnMain, models.ColumnSide:
+		return layout.Columns[name]
+	}
+	if contains(m.Side, name) {
+		return models.ColumnSide
+	}
+	return models.ColumnMain
+}
@@ -0,0 +1,136 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+func TestValidateSectionLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  models.SectionLayout
+		wantErr bool
+	}{
+		{name: "empty"},
+		{
+			name: "every setting",
+			layout: models.SectionLayout{
+				Order:   []string{models.SectionEducation, models.SectionWork},
+				Hidden:  []string{models.SectionInterests},
+				Columns: map[string]string{models.SectionSkills: models.ColumnMain, models.SectionWork: models.ColumnSide},
+			},
+		},
+		{name: "section the template may not render", layout: models.SectionLayout{Order: []string{models.SectionCustom}}},
+		{name: "ordered twice", layout: models.SectionLayout{Order: []string{models.SectionWork, models.SectionEducation, models.SectionWork}}, wantErr: true},
+		{name: "hidden twice", layout: models.SectionLayout{Hidden: []string{models.SectionWork, models.SectionWork}}},
+		{name: "unknown ordered section", layout: models.SectionLayout{Order: []string{"hobbies"}}, wantErr: true},
+		{name: "unknown hidden section", layout: models.SectionLayout{Hidden: []string{"hobbies"}}, wantErr: true},
+		{name: "unknown section in a column", layout: models.SectionLayout{Columns: map[string]string{"hobbies": models.ColumnSide}}, wantErr: true},
+		{name: "unknown column", layout: models.SectionLayout{Columns: map[string]string{models.SectionWork: "left"}}, wantErr: true},
+		{name: "basics ordered", layout: models.SectionLayout{Order: []string{models.SectionBasics}}, wantErr: true},
+		{name: "basics hidden", layout: models.SectionLayout{Hidden: []string{models.SectionBasics}}, wantErr: true},
+		{name: "basics in a column", layout: models.SectionLayout{Columns: map[string]string{models.SectionBasics: models.ColumnSide}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSectionLayout(tt.layout); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSectionLayout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestManifestSections(t *testing.T) {
+	twoColumns := Manifest{
+		Sections: []string{models.SectionBasics, models.SectionWork, models.SectionProjects, models.SectionEducation, models.SectionSkills, models.SectionLanguages},
+		Side:     []string{models.SectionEducation, models.SectionSkills, models.SectionLanguages},
+	}
+	oneColumn := Manifest{
+		Sections: []string{models.SectionBasics, models.SectionSkills, models.SectionWork, models.SectionEducation},
+	}
+
+	tests := []struct {
+		name     string
+		manifest Manifest
+		layout   models.SectionLayout
+		column   string
+		want     []string
+	}{
+		{
+			name:     "template order",
+			manifest: twoColumns,
+			column:   models.ColumnMain,
+			want:     []string{models.SectionWork, models.SectionProjects},
+		},
+		{
+			name:     "template side",
+			manifest: twoColumns,
+			column:   models.ColumnSide,
+			want:     []string{models.SectionEducation, models.SectionSkills, models.SectionLanguages},
+		},
+		{
+			name:     "ordered sections first",
+			manifest: twoColumns,
+			layout:   models.SectionLayout{Order: []string{models.SectionLanguages, models.SectionEducation}},
+			column:   models.ColumnSide,
+			want:     []string{models.SectionLanguages, models.SectionEducation, models.SectionSkills},
+		},
+		{
+			name:     "sections the template does not render",
+			manifest: twoColumns,
+			layout:   models.SectionLayout{Order: []string{models.SectionCustom, models.SectionProjects}},
+			column:   models.ColumnMain,
+			want:     []string{models.SectionProjects, models.SectionWork},
+		},
+		{
+			name:     "hidden",
+			manifest: twoColumns,
+			layout:   models.SectionLayout{Hidden: []string{models.SectionSkills, models.SectionWork}},
+			column:   models.ColumnSide,
+			want:     []string{models.SectionEducation, models.SectionLanguages},
+		},
+		{
+			name:     "section moved to the main column",
+			manifest: twoColumns,
+			layout:   models.SectionLayout{Columns: map[string]string{models.SectionSkills: models.ColumnMain, models.SectionWork: models.ColumnSide}},
+			column:   models.ColumnMain,
+			want:     []string{models.SectionProjects, models.SectionSkills},
+		},
+		{
+			name:     "section moved to the side column",
+			manifest: twoColumns,
+			layout:   models.SectionLayout{Columns: map[string]string{models.SectionWork: models.ColumnSide}, Order: []string{models.SectionWork}},
+			column:   models.ColumnSide,
+			want:     []string{models.SectionWork, models.SectionEducation, models.SectionSkills, models.SectionLanguages},
+		},
+		{
+			name:     "single column ignores columns",
+			manifest: oneColumn,
+			layout:   models.SectionLayout{Order: []string{models.SectionEducation}, Hidden: []string{models.SectionWork}, Columns: map[string]string{models.SectionSkills: models.ColumnSide}},
+			want:     []string{models.SectionEducation, models.SectionSkills},
+		},
+		{
+			name:     "custom template renders every section",
+			manifest: Manifest{},
+			layout:   models.SectionLayout{Hidden: []string{models.SectionWork, models.SectionProjects, models.SectionEducation, models.SectionCertificates, models.SectionSkills, models.SectionSoftSkills, models.SectionLanguages, models.SectionInterests}},
+			want:     []string{models.SectionVolunteer, models.SectionAwards, models.SectionPublications, models.SectionReferences, models.SectionCustom},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resume := models.Resume{Meta: models.Meta{Layout: tt.layout}}
+
+			var got []string
+			for _, section := range tt.manifest.sections(resume, tt.column) {
+				got = append(got, section.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sections() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package template
+
+import (
+	"fmt"
+	"html/template"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/fonts"
+	"github.com/pkg/errors"
+)
+
+const (
+	minThemeFontSize = 9
+	maxThemeFontSize = 18
+	// defaultBaseFontSize is the body text size of templates that do not declare one
+	defaultBaseFontSize = 14
+	// minAccentContrast is the WCAG AA ratio for body text, the accent is used for
+	// text on the white page and as background of light text
+	minAccentContrast = 4.5
+)
+
+var hexColorRe = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// densities scale the spacing and the line height of the templates.
+var densities = map[string]struct {
+	spacing float64
+	line    float64
+}{
+	models.DensityCompact: {spacing: 0.7, line: 0.92},
+	models.DensityNormal:  {spacing: 1, line: 1},
+	models.DensityRelaxed: {spacing: 1.3, line: 1.1},
+}
+
+// ValidateTheme checks that every value of the theme is supported and that the
+// accent color keeps the text readable.
+func ValidateTheme(theme models.Theme) error {
+	if theme.AccentColor != "" {
+		accent, ok := parseHexColor(theme.AccentColor)
+		if !ok {
+			return errors.New(fmt.Sprintf("accent color %q is not a #RGB or #RRGGBB color", theme.AccentColor))
+		}
+		if ratio := contrastRatio(accent, [3]float64{255, 255, 255}); ratio < minAccentContrast {
+			return errors.New(fmt.Sprintf("accent color %s has a contrast of %.2f:1 with the white page, at least %.1f:1 is needed to keep the text readable", theme.AccentColor, ratio, minAccentContrast))
+		}
+	}
+	if _, ok := fonts.Lookup(theme.FontFamily); theme.FontFamily != "" && !ok {
+		return errors.New(fmt.Sprintf("font family %q is not available, use one of %s", theme.FontFamily, strings.Join(fonts.Names(), ", ")))
+	}
+	if theme.FontSize != 0 && (theme.FontSize < minThemeFontSize || theme.FontSize > maxThemeFontSize) {
+		return errors.New(fmt.Sprintf("font size must be between %d and %d pixels", minThemeFontSize, maxThemeFontSize))
+	}
+	if _, ok := densities[theme.Density]; theme.Density != "" && !ok {
+		return errors.New(fmt.Sprintf("unknown density %q", theme.Density))
+	}
+
+	return nil
+}
+
+// themeStyle returns the CSS overriding the theme variables of a template. Invalid values
+// are left out, they never reach the stylesheet even when the theme was not validated.
+func themeStyle(theme models.Theme, baseFontSize float64) template.CSS {
+	var variables []string
+
+	if _, ok := parseHexColor(theme.AccentColor); ok {
+		variables = append(variables, "--accent-color: "+theme.AccentColor)
+	}
+	if family, ok := fonts.Lookup(theme.FontFamily); ok {
+		variables = append(variables, "--main-font: "+family.Stack(), "--secondary-font: "+family.Stack())
+	}
+	if theme.FontSize >= minThemeFontSize && theme.FontSize <= maxThemeFontSize && baseFontSize > 0 {
+		variables = append(variables, "--font-scale: "+formatFloat(theme.FontSize/baseFontSize))
+	}
+	if density, ok := densities[theme.Density]; ok {
+		variables = append(variables,
+			"--spacing-scale: "+formatFloat(density.spacing),
+			"--line-scale: "+formatFloat(density.line))
+	}
+
+	if len(variables) == 0 {
+		return ""
+	}
+
+	// html:root outranks the :root rule of the template that follows
+	return template.CSS("html:root {\n        " + strings.Join(variables, ";\n        ") + ";\n    }")
+}
+
+func parseHexColor(s string) ([3]float64, bool) {
+	if !hexColorRe.MatchString(s) {
+		return [3]float64{}, false
+	}
+
+	hex := s[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	var rgb [3]float64
+	for i := range rgb {
+		v, _ := strconv.ParseUint(hex[i*2:i*2+2], 16, 8)
+		rgb[i] = float64(v)
+	}
+	return rgb, true
+}
+
+// contrastRatio follows the WCAG 2 definition, from 1:1 to 21:1.
+func contrastRatio(a, b [3]float64) float64 {
+	la, lb := relativeLuminance(a), relativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+func relativeLuminance(rgb [3]float64) float64 {
+	var channels [3]float64
+	for i, v := range rgb {
+		c := v / 255
+		if c <= 0.03928 {
+			channels[i] = c / 12.92
+		} else {
+			channels[i] = math.Pow((c+0.055)/1.055, 2.4)
+		}
+	}
+	return 0.2126*channels[0] + 0.7152*channels[1] + 0.0722*channels[2]
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(math.Round(f*1e4)/1e4, 'f', -1, 64)
+}
@@ -0,0 +1,81 @@
+package template
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+)
+
+func TestValidateTheme(t *testing.T) {
+	tests := []struct {
+		name    string
+		theme   models.Theme
+		wantErr bool
+	}{
+		{name: "defaults"},
+		{name: "dark accent", theme: models.Theme{AccentColor: "#1F4E79"}},
+		{name: "short accent", theme: models.Theme{AccentColor: "#036"}},
+		{name: "light accent", theme: models.Theme{AccentColor: "#FFD700"}, wantErr: true},
+		{name: "named accent", theme: models.Theme{AccentColor: "navy"}, wantErr: true},
+		{name: "accent with CSS", theme: models.Theme{AccentColor: "#000;}body{display:none"}, wantErr: true},
+		{name: "font", theme: models.Theme{FontFamily: "Open Sans"}},
+		{name: "unknown font", theme: models.Theme{FontFamily: "Comic Sans MS"}, wantErr: true},
+		{name: "smallest size", theme: models.Theme{FontSize: minThemeFontSize}},
+		{name: "largest size", theme: models.Theme{FontSize: maxThemeFontSize}},
+		{name: "size too small", theme: models.Theme{FontSize: minThemeFontSize - 1}, wantErr: true},
+		{name: "size too large", theme: models.Theme{FontSize: maxThemeFontSize + 0.5}, wantErr: true},
+		{name: "density", theme: models.Theme{Density: models.DensityCompact}},
+		{name: "unknown density", theme: models.Theme{Density: "airy"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateTheme(tt.theme); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTheme() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestThemeStyle(t *testing.T) {
+	style := string(themeStyle(models.Theme{
+		AccentColor: "#1F4E79",
+		FontFamily:  "Open Sans",
+		FontSize:    12,
+		Density:     models.DensityCompact,
+	}, 14))
+	for _, s := range []string{
+		"--accent-color: #1F4E79;",
+		"--main-font: 'Open Sans'",
+		"--secondary-font: 'Open Sans'",
+		"--font-scale: 0.8571;",
+		"--spacing-scale: 0.7;",
+		"--line-scale: 0.92;",
+	} {
+		if !strings.Contains(style, s) {
+			t.Errorf("themeStyle() = %q, misses %q", style, s)
+		}
+	}
+
+	// a template without base font size is not scaled
+	if style := string(themeStyle(models.Theme{FontSize: 12}, 0)); style != "" {
+		t.Errorf("themeStyle() = %q without base font size, want none", style)
+	}
+
+	// values that were not validated never reach the stylesheet
+	invalid := models.Theme{AccentColor: "#000;}body{display:none", FontFamily: "x'}body{display:none", FontSize: 100, Density: "airy"}
+	if style := string(themeStyle(invalid, 14)); style != "" {
+		t.Errorf("themeStyle() = %q for invalid values, want none", style)
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	black, white := [3]float64{0, 0, 0}, [3]float64{255, 255, 255}
+	if got := contrastRatio(black, white); formatFloat(got) != "21" {
+		t.Errorf("contrastRatio(black, white) = %v, want 21", got)
+	}
+	if got := contrastRatio(white, white); got != 1 {
+		t.Errorf("contrastRatio(white, white) = %v, want 1", got)
+	}
+}
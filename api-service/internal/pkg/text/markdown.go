@@ -0,0 +1,65 @@
+package text
+
+import (
+	"strings"
+)
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"[", `\[`,
+	"]", `\]`,
+	"<", `\<`,
+	">", `\>`,
+	"#", `\#`,
+)
+
+// markdownWriter renders CommonMark, user text is escaped so it never turns into markup.
+type markdownWriter struct {
+	block
+}
+
+func (w *markdownWriter) title(name, label string) {
+	w.write("# "+w.escape(name), false)
+	if label != "" {
+		w.write("**"+w.escape(label)+"**", false)
+	}
+}
+
+func (w *markdownWriter) section(label string) {
+	w.write("## "+w.escape(label), false)
+}
+
+func (w *markdownWriter) entry(title string) {
+	w.write("### "+w.escape(title), false)
+}
+
+func (w *markdownWriter) meta(text string) {
+	if text == "" {
+		return
+	}
+	w.write("*"+text+"*", false)
+}
+
+func (w *markdownWriter) paragraph(text string) {
+	// two trailing spaces keep the line breaks of multi-line summaries
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	w.write(strings.Join(lines, "  \n"), false)
+}
+
+func (w *markdownWriter) bullet(text string) {
+	w.write("- "+text, true)
+}
+
+func (w *markdownWriter) link(url, label string) string {
+	if label == "" {
+		label = url
+	}
+	return "[" + w.escape(label) + "](<" + strings.NewReplacer("<", "%3C", ">", "%3E").Replace(url) + ">)"
+}
+
+func (w *markdownWriter) escape(text string) string {
+	return markdownEscaper.Replace(text)
+}
@@ -0,0 +1,58 @@
+package text
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// plainWriter renders headings in capitals with an underline, which parsers read reliably.
+type plainWriter struct {
+	block
+}
+
+func (w *plainWriter) title(name, label string) {
+	w.write(strings.ToUpper(name), false)
+	if label != "" {
+		w.buf.WriteString(label + "\n")
+	}
+}
+
+func (w *plainWriter) section(label string) {
+	label = strings.ToUpper(label)
+	w.write(label+"\n"+strings.Repeat("=", utf8.RuneCountInString(label)), false)
+}
+
+func (w *plainWriter) entry(title string) {
+	w.write(title, false)
+}
+
+// meta lines stay attached to the entry above them.
+func (w *plainWriter) meta(text string) {
+	if text == "" {
+		return
+	}
+	w.buf.WriteString(text + "\n")
+	w.inList = false
+}
+
+func (w *plainWriter) paragraph(text string) {
+	w.write(strings.TrimSpace(text), false)
+}
+
+func (w *plainWriter) bullet(text string) {
+	w.write("- "+text, true)
+}
+
+func (w *plainWriter) link(url, label string) string {
+	if label == "" {
+		return url
+	}
+	if label == url || "mailto:"+label == url {
+		return label
+	}
+	return label + " (" + url + ")"
+}
+
+func (w *plainWriter) escape(text string) string {
+	return text
+}
@@ -0,0 +1,346 @@
+package text
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/export"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/logger"
+	"github.com/pkg/errors"
+)
+
+// Renderer writes a resume as a single column document for applicant tracking systems.
+// Sections always come in the same order, whatever the layout of the chosen template.
+type Renderer struct {
+	markdown bool
+}
+
+// NewPlainRenderer creates a Renderer producing plain UTF-8 text.
+func NewPlainRenderer() *Renderer {
+	return &Renderer{}
+}
+
+// NewMarkdownRenderer creates a Renderer producing CommonMark.
+func NewMarkdownRenderer() *Renderer {
+	return &Renderer{markdown: true}
+}
+
+// Render returns the document content of the resume.
+func (r *Renderer) Render(resumeData models.Resume) ([]byte, error) {
+	startedAt := time.Now()
+
+	var w writer = &plainWriter{}
+	if r.markdown {
+		w = &markdownWriter{}
+	}
+
+	writeBasics(w, &resumeData)
+	writeWork(w, &resumeData)
+	writeEducation(w, &resumeData)
+	writeProjects(w, &resumeData)
+	writeCertificates(w, &resumeData)
+	writeSkills(w, resumeData.GetSkillsLabel(), resumeData.Skills)
+	writeSkills(w, resumeData.GetSoftSkillsLabel(), resumeData.SoftSkills)
+	writeLanguages(w, &resumeData)
+	writeInterests(w, &resumeData)
+	writeVolunteer(w, &resumeData)
+	writeAwards(w, &resumeData)
+	writePublications(w, &resumeData)
+	writeReferences(w, &resumeData)
+	writeCustomSections(w, &resumeData)
+
+	data := w.bytes()
+	if len(data) == 0 {
+		return nil, errors.New("Render - resume is empty")
+	}
+
+	logger.Error(errors.New(fmt.Sprintf("text document of %d bytes generated in %f seconds", len(data), time.Since(startedAt).Seconds())))
+
+	return data, nil
+}
+
+// writer abstracts the markup of the two output formats.
+type writer interface {
+	title(name, label string)
+	section(label string)
+	entry(title string)
+	meta(text string)
+	paragraph(text string)
+	bullet(text string)
+	link(url, label string) string
+	escape(text string) string
+	bytes() []byte
+}
+
+func writeBasics(w writer, resumeData *models.Resume) {
+	basics := resumeData.Basics
+
+	w.title(basics.Name, basics.Label)
+
+	var contacts []string
+	if basics.Email != "" {
+		contacts = append(contacts, w.link("mailto:"+basics.Email, basics.Email))
+	}
+	if basics.Phone != "" {
+		contacts = append(contacts, w.escape(basics.Phone))
+	}
+	if location := export.Location(basics.Location); location != "" {
+		contacts = append(contacts, w.escape(location))
+	}
+	w.meta(strings.Join(contacts, " | "))
+
+	var links []string
+	if basics.URL != "" {
+		links = append(links, w.link(export.AbsoluteURL(basics.URL), basics.URL))
+	}
+	for _, profile := range basics.Profiles {
+		links = append(links, w.link(export.AbsoluteURL(profile.URL), profile.Network))
+	}
+	w.meta(strings.Join(links, " | "))
+
+	if basics.Summary != "" {
+		w.section(resumeData.GetProfileLabel())
+		w.paragraph(w.escape(basics.Summary))
+	}
+}
+
+func writeWork(w writer, resumeData *models.Resume) {
+	if len(resumeData.Work) == 0 {
+		return
+	}
+
+	w.section(resumeData.GetExperiencesLabel())
+	since := resumeData.GetSinceLabel()
+
+	for _, work := range resumeData.Work {
+		title := work.Position
+		if work.ContractType != "" {
+			title += " (" + work.ContractType + ")"
+		}
+		w.entry(export.JoinNonEmpty(" | ", title, work.Company))
+		w.meta(w.escape(export.JoinNonEmpty(" | ", work.Location, export.DateRange(work.StartDate, work.EndDate, since))))
+		w.paragraph(w.escape(work.Summary))
+		for _, skill := range work.Skills {
+			w.bullet(w.escape(skill))
+		}
+	}
+}
+
+func writeEducation(w writer, resumeData *models.Resume) {
+	if len(resumeData.Education) == 0 {
+		return
+	}
+
+	w.section(resumeData.GetEducationLabel())
+
+	for _, education := range resumeData.Education {
+		w.entry(export.JoinNonEmpty(" | ", export.JoinNonEmpty(" ", education.StudyType, education.Area), education.Institution))
+		w.meta(w.escape(export.JoinNonEmpty(" | ", education.Location, export.JoinNonEmpty(" - ", education.StartDate, education.EndDate))))
+		w.paragraph(w.escape(education.Score))
+		for _, course := range education.Courses {
+			w.bullet(w.escape(course))
+		}
+	}
+}
+
+func writeProjects(w writer, resumeData *models.Resume) {
+	if len(resumeData.Projects) == 0 {
+		return
+	}
+
+	w.section(resumeData.GetProjectsLabel())
+
+	for _, project := range resumeData.Projects {
+		w.entry(project.Name)
+		if project.URL != "" {
+			w.meta(w.link(export.AbsoluteURL(project.URL), project.URL))
+		}
+		w.paragraph(w.escape(project.Description))
+	}
+}
+
+func writeCertificates(w writer, resumeData *models.Resume) {
+	if len(resumeData.Certificates) == 0 {
+		return
+	}
+
+	w.section(resumeData.GetCertificationsLabel())
+
+	for _, certificate := range resumeData.Certificates {
+		line := w.escape(certificate.Title)
+		if details := export.JoinNonEmpty(", ", certificate.Issuer, certificate.Date, certificate.Score); details != "" {
+			line += " (" + w.escape(details) + ")"
+		}
+		if certificate.URL != "" {
+			line += " " + w.link(export.AbsoluteURL(certificate.URL), certificate.URL)
+		}
+		w.bullet(line)
+	}
+}
+
+func writeSkills(w writer, label string, skills []models.Skill) {
+	if len(skills) == 0 {
+		return
+	}
+
+	w.section(label)
+
+	for _, skill := range skills {
+		line := skill.Name
+		if skill.Level != "" {
+			line += " (" + skill.Level + ")"
+		}
+		if len(skill.Keywords) > 0 {
+			line += ": " + strings.Join(skill.Keywords, ", ")
+		}
+		w.bullet(w.escape(line))
+	}
+}
+
+func writeLanguages(w writer, resumeData *models.Resume) {
+	if len(resumeData.Languages) == 0 {
+		return
+	}
+
+	w.section(resumeData.GetLanguagesLabel())
+
+	for _, language := range resumeData.Languages {
+		line := language.Language
+		if language.Fluency != "" {
+			line += " (" + language.Fluency + ")"
+		}
+		w.bullet(w.escape(line))
+	}
+}
+
+func writeInterests(w writer, resumeData *models.Resume) {
+	if len(resumeData.Interests) == 0 {
+		return
+	}
+
+	w.section(resumeData.GetInterestsLabel())
+
+	for _, interest := range resumeData.Interests {
+		line := interest.Name
+		if len(interest.Keywords) > 0 {
+			line += ": " + strings.Join(interest.Keywords, ", ")
+		}
+		w.bullet(w.escape(line))
+	}
+}
+
+func writeVolunteer(w writer, resumeData *models.Resume) {
+	if len(resumeData.Volunteer) == 0 {
+		return
+	}
+
+	w.section(resumeData.GetVolunteerLabel())
+	since := resumeData.GetSinceLabel()
+
+	for _, volunteer := range resumeData.Volunteer {
+		w.entry(export.JoinNonEmpty(" | ", volunteer.Position, volunteer.Organization))
+		w.meta(w.escape(export.DateRange(volunteer.StartDate, volunteer.EndDate, since)))
+		if volunteer.URL != "" {
+			w.meta(w.link(export.AbsoluteURL(volunteer.URL), volunteer.URL))
+		}
+		w.paragraph(w.escape(volunteer.Summary))
+		for _, highlight := range volunteer.Highlights {
+			w.bullet(w.escape(highlight))
+		}
+	}
+}
+
+func writeAwards(w writer, resumeData *models.Resume) {
+	if len(resumeData.Awards) == 0 {
+		return
+	}
+
+	w.section(resumeData.GetAwardsLabel())
+
+	for _, award := range resumeData.Awards {
+		w.entry(award.Title)
+		w.meta(w.escape(export.JoinNonEmpty(" | ", award.Awarder, award.Date)))
+		w.paragraph(w.escape(award.Summary))
+	}
+}
+
+func writePublications(w writer, resumeData *models.Resume) {
+	if len(resumeData.Publications) == 0 {
+		return
+	}
+
+	w.section(resumeData.GetPublicationsLabel())
+
+	for _, publication := range resumeData.Publications {
+		w.entry(publication.Name)
+		w.meta(w.escape(export.JoinNonEmpty(" | ", publication.Publisher, publication.ReleaseDate)))
+		if publication.URL != "" {
+			w.meta(w.link(export.AbsoluteURL(publication.URL), publication.URL))
+		}
+		w.paragraph(w.escape(publication.Summary))
+	}
+}
+
+func writeReferences(w writer, resumeData *models.Resume) {
+	if len(resumeData.References) == 0 {
+		return
+	}
+
+	w.section(resumeData.GetReferencesLabel())
+
+	for _, reference := range resumeData.References {
+		w.entry(reference.Name)
+		w.paragraph(w.escape(reference.Reference))
+	}
+}
+
+// writeCustomSections writes every custom section under the title given by the user.
+func writeCustomSections(w writer, resumeData *models.Resume) {
+	since := resumeData.GetSinceLabel()
+
+	for _, section := range resumeData.CustomSections {
+		if len(section.Entries) == 0 {
+			continue
+		}
+
+		w.section(section.Title)
+
+		for _, entry := range section.Entries {
+			w.entry(export.JoinNonEmpty(" | ", entry.Title, entry.Subtitle))
+			w.meta(w.escape(export.JoinNonEmpty(" | ", entry.Location, export.DateRange(entry.StartDate, entry.EndDate, since))))
+			if entry.URL != "" {
+				w.meta(w.link(export.AbsoluteURL(entry.URL), entry.URL))
+			}
+			w.paragraph(w.escape(entry.Summary))
+			for _, highlight := range entry.Highlights {
+				w.bullet(w.escape(highlight))
+			}
+		}
+	}
+}
+
+// block keeps exactly one blank line between the blocks of a document.
+type block struct {
+	buf bytes.Buffer
+	// inList is set while consecutive bullets are written, they are not separated by blank lines
+	inList bool
+}
+
+func (b *block) write(text string, list bool) {
+	if text == "" {
+		return
+	}
+	if b.buf.Len() > 0 && !(list && b.inList) {
+		b.buf.WriteString("\n")
+	}
+	b.buf.WriteString(text)
+	b.buf.WriteString("\n")
+	b.inList = list
+}
+
+func (b *block) bytes() []byte {
+	return b.buf.Bytes()
+}
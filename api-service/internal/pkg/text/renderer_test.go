@@ -0,0 +1,125 @@
+package text_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/api/models"
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/text"
+)
+
+const exampleFile = "../../../examples/example.resume.json"
+
+func exampleResume(t *testing.T) models.Resume {
+	t.Helper()
+
+	data, err := os.ReadFile(exampleFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resume models.Resume
+	if err := json.Unmarshal(data, &resume); err != nil {
+		t.Fatal(err)
+	}
+	return resume
+}
+
+func TestRender(t *testing.T) {
+	resume := exampleResume(t)
+	resume.Basics.Profiles = append(resume.Basics.Profiles, models.Profile{Network: "Phone", URL: "tel:07123400808"})
+
+	tests := []struct {
+		name     string
+		renderer *text.Renderer
+		want     []string
+	}{
+		{
+			name:     "plain",
+			renderer: text.NewPlainRenderer(),
+			want: []string{
+				"JOHN DOE RUST\nDevOps Engineer\n", "EXPERIENCES\n===========",
+				"johndoerust@mail.com | 07123400808 | Paris, Ile-de-France, FR",
+				"jdoerust.com (https://jdoerust.com)", "GitHub (https://github.com/johndoerust)", "Phone (tel:07123400808)",
+				"French Med Company", "Alta Nova University", "CloudFormation Templates", "Docker Certified Associate (DCA)",
+			},
+		},
+		{
+			name:     "markdown",
+			renderer: text.NewMarkdownRenderer(),
+			want: []string{
+				"# John Doe Rust", "## Experiences", "### DevOps Engineer | French Med Company",
+				"[johndoerust@mail.com](<mailto:johndoerust@mail.com>)",
+				"[jdoerust.com](<https://jdoerust.com>)", "[Phone](<tel:07123400808>)",
+				"French Med Company", "Alta Nova University", "CloudFormation Templates", "Docker Certified Associate (DCA)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.renderer.Render(resume)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range tt.want {
+				if !strings.Contains(string(data), s) {
+					t.Errorf("document misses %q:\n%s", s, data)
+				}
+			}
+			if strings.Contains(string(data), "https://tel:") {
+				t.Errorf("the phone link got a web scheme:\n%s", data)
+			}
+		})
+	}
+}
+
+func TestRenderEmpty(t *testing.T) {
+	if _, err := text.NewPlainRenderer().Render(models.Resume{}); err == nil {
+		t.Error("Render() of an empty resume succeeded, want an error")
+	}
+}
+
+// sectionsResume fills the sections that have no column of their own in most templates.
+var sectionsResume = models.Resume{
+	Basics:       models.Basics{Name: "John Doe"},
+	Volunteer:    []models.Volunteer{{Organization: "Code Club", Position: "Mentor", StartDate: "2021-01", Highlights: []string{"Taught Go to teenagers"}}},
+	Awards:       []models.Award{{Title: "Gopher of the Year", Awarder: "Go Community", Date: "2023"}},
+	Publications: []models.Publication{{Name: "Concurrency in Practice", Publisher: "Go Weekly", URL: "go.dev/blog"}},
+	References:   []models.Reference{{Name: "Jane Roe", Reference: "John shipped every release on time."}},
+	CustomSections: []models.CustomSection{
+		{Title: "Talks", Entries: []models.CustomEntry{{Title: "Scaling Go services", Subtitle: "GopherCon EU", Highlights: []string{"500 attendees"}}}},
+		{Title: "Empty"},
+	},
+}
+
+func TestRenderWritesEverySection(t *testing.T) {
+	want := []string{
+		"Code Club", "Taught Go to teenagers",
+		"Gopher of the Year", "Go Community",
+		"Concurrency in Practice", "https://go.dev/blog",
+		"Jane Roe", "John shipped every release on time.",
+		"Talks", "Scaling Go services | GopherCon EU", "500 attendees",
+	}
+
+	for name, renderer := range map[string]*text.Renderer{"plain": text.NewPlainRenderer(), "markdown": text.NewMarkdownRenderer()} {
+		t.Run(name, func(t *testing.T) {
+			data, err := renderer.Render(sectionsResume)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			document := strings.ToLower(string(data))
+			for _, s := range want {
+				if !strings.Contains(document, strings.ToLower(s)) {
+					t.Errorf("document misses %q:\n%s", s, data)
+				}
+			}
+			// custom sections without entries have no heading
+			if strings.Contains(document, "empty") {
+				t.Errorf("document has a heading for an empty custom section:\n%s", data)
+			}
+		})
+	}
+}
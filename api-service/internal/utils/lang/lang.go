@@ -1,20 +1,32 @@
 package lang
 
 import (
+	"embed"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/pkg/logger"
 	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/json"
 	"golang.org/x/text/language"
-	"path/filepath"
+	"path"
 	"strings"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 )
 
-const localesDir = "internal/utils/lang/locales"
+const localesDir = "locales"
+
+// locales are embedded so translations do not depend on the working directory.
+//
+//go:embed locales/*.json
+var localesFS embed.FS
+
+// defaultLanguage is the last step of every fallback chain, it has every message.
+const defaultLanguage = "en"
 
 var bundle *i18n.Bundle
 
-var supportedLanguages = []string{"en", "fr"}
+var supportedLanguages = []string{"en", "fr", "ar", "fa", "uz", "uz-Cyrl", "ru"}
+
+// matcher picks the supported language closest to the ones a client accepts.
+var matcher language.Matcher
 
 func init() {
 	bundle = i18n.NewBundle(language.English)
@@ -32,16 +44,133 @@ func init() {
 			continue
 		}
 	}
+
+	tags := make([]language.Tag, 0, len(supportedLanguages))
+	for _, lang := range supportedLanguages {
+		tags = append(tags, language.Make(lang))
+	}
+	matcher = language.NewMatcher(tags)
 }
 
 func loadTranslation(lang string) (*i18n.MessageFile, error) {
-	translationFile := filepath.Join(localesDir, strings.ToLower(lang)+".json")
-	return bundle.LoadMessageFile(translationFile)
+	translationFile := path.Join(localesDir, strings.ToLower(lang)+".json")
+	data, err := localesFS.ReadFile(translationFile)
+	if err != nil {
+		return nil, err
+	}
+	return bundle.ParseMessageFileBytes(data, translationFile)
+}
+
+// SupportedLanguages returns the languages labels are translated to.
+func SupportedLanguages() []string {
+	return append([]string(nil), supportedLanguages...)
+}
+
+// Text directions of the languages, as the HTML dir attribute takes them.
+const (
+	LTR = "ltr"
+	RTL = "rtl"
+)
+
+// rtlScripts are the scripts written from right to left.
+var rtlScripts = map[string]bool{
+	"Arab": true,
+	"Hebr": true,
+	"Syrc": true,
+	"Thaa": true,
+	"Nkoo": true,
+	"Adlm": true,
+	"Rohg": true,
+}
+
+// Direction returns the text direction of a language, from its script when it is given
+// and from the script the language is usually written in otherwise.
+func Direction(lang string) string {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return LTR
+	}
+	if script, _ := tag.Script(); rtlScripts[script.String()] {
+		return RTL
+	}
+	return LTR
 }
 
+// FromAcceptLanguage returns the supported language matching an Accept-Language header
+// best, English when it is empty or matches none of them.
+func FromAcceptLanguage(header string) string {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return defaultLanguage
+	}
+	_, i, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return defaultLanguage
+	}
+	return supportedLanguages[i]
+}
+
+// Translate returns the message in the language. A message the language has no
+// translation for is looked up in its base language and then in English, the
+// message ID itself is returned when even English lacks it.
 func Translate(lang string, messageID string) string {
-	return i18n.NewLocalizer(bundle, lang).
-		MustLocalize(&i18n.LocalizeConfig{
-			MessageID: messageID,
-		})
+	return localize(lang, &i18n.LocalizeConfig{
+		MessageID: messageID,
+	})
+}
+
+// TranslateData is Translate for messages with {{.Name}} placeholders filled from data.
+func TranslateData(lang string, messageID string, data map[string]interface{}) string {
+	return localize(lang, &i18n.LocalizeConfig{
+		MessageID:    messageID,
+		TemplateData: data,
+	})
+}
+
+// TranslatePlural is Translate for messages with plural forms, {{.Count}} is replaced
+// by count.
+func TranslatePlural(lang string, messageID string, count int) string {
+	return localize(lang, &i18n.LocalizeConfig{
+		MessageID:    messageID,
+		PluralCount:  count,
+		TemplateData: map[string]interface{}{"Count": count},
+	})
+}
+
+func localize(lang string, config *i18n.LocalizeConfig) string {
+	for _, tag := range fallbacks(lang) {
+		message, err := i18n.NewLocalizer(bundle, tag).Localize(config)
+		// the localizer answers in English with an error when the language lacks the message
+		if err == nil {
+			return message
+		}
+	}
+	return config.MessageID
+}
+
+// fallbacks returns the languages a message is looked up in: the language, its base
+// language and English.
+func fallbacks(lang string) []string {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return []string{defaultLanguage}
+	}
+	base, _ := tag.Base()
+
+	var chain []string
+	for _, lang := range []string{tag.String(), base.String(), defaultLanguage} {
+		if !contains(chain, lang) {
+			chain = append(chain, lang)
+		}
+	}
+	return chain
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
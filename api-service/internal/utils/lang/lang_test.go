@@ -0,0 +1,75 @@
+package lang
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dostonshernazarov/resume_maker/api-service/internal/utils/json"
+)
+
+func readLocale(t *testing.T, lang string) map[string]interface{} {
+	t.Helper()
+
+	data, err := localesFS.ReadFile(localesDir + "/" + lang + ".json")
+	if err != nil {
+		t.Fatalf("locale %s: %v", lang, err)
+	}
+	var messages map[string]interface{}
+	if err := json.Unmarshal(data, &messages); err != nil {
+		t.Fatalf("locale %s: %v", lang, err)
+	}
+	return messages
+}
+
+func TestLocalesHaveEveryEnglishKey(t *testing.T) {
+	english := readLocale(t, defaultLanguage)
+
+	for _, lang := range supportedLanguages {
+		messages := readLocale(t, strings.ToLower(lang))
+		for key := range english {
+			if message, ok := messages[key]; !ok || message == "" {
+				t.Errorf("locale %s lacks %s", lang, key)
+			}
+		}
+	}
+}
+
+func TestTranslateFallsBack(t *testing.T) {
+	tests := []struct {
+		lang      string
+		messageID string
+		want      string
+	}{
+		{lang: "fr", messageID: "SkillsLabel", want: "Compétences Techniques"},
+		{lang: "fr-CA", messageID: "SkillsLabel", want: "Compétences Techniques"},
+		{lang: "uz-Cyrl", messageID: "SkillsLabel", want: "Кўникмалар"},
+		{lang: "de", messageID: "SkillsLabel", want: "Skills"},
+		{lang: "", messageID: "SkillsLabel", want: "Skills"},
+		{lang: "not a language", messageID: "SkillsLabel", want: "Skills"},
+		{lang: "ru", messageID: "UnknownLabel", want: "UnknownLabel"},
+	}
+
+	for _, tt := range tests {
+		if got := Translate(tt.lang, tt.messageID); got != tt.want {
+			t.Errorf("Translate(%q, %q) = %q, want %q", tt.lang, tt.messageID, got, tt.want)
+		}
+	}
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	tests := map[string]string{
+		"":                        "en",
+		"ru-RU,ru;q=0.9,en;q=0.8": "ru",
+		"uz-Cyrl-UZ":              "uz-Cyrl",
+		"uz":                      "uz",
+		"de-DE,fr;q=0.5":          "fr",
+		"ja":                      "en",
+		"not a header;;":          "en",
+	}
+
+	for header, want := range tests {
+		if got := FromAcceptLanguage(header); got != want {
+			t.Errorf("FromAcceptLanguage(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
@@ -86,11 +86,13 @@ func (a *App) Run() error {
 
 	// repositories initialization
 	resumeRepo := postgresql.NewResumeRepo(a.DB)
+	coverLetterRepo := postgresql.NewCoverLetterRepo(a.DB)
 
 	// useCase initialization
 	resumeUseCase := usecase.NewResumeService(contextTimeout, resumeRepo)
+	coverLetterUseCase := usecase.NewCoverLetterService(contextTimeout, coverLetterRepo)
 
-	resume_service.RegisterResumeServiceServer(a.GrpcServer, services.NewRPC(a.Logger, resumeUseCase, &a.ServiceClients))
+	resume_service.RegisterResumeServiceServer(a.GrpcServer, services.NewRPC(a.Logger, resumeUseCase, coverLetterUseCase, &a.ServiceClients))
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 	if err := server.Run(a.Config, a.GrpcServer); err != nil {
 		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
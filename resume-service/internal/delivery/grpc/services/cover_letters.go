@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"github.com/dostonshernazarov/resume_maker/user-service/genproto/resume_service"
+	"github.com/dostonshernazarov/resume_maker/user-service/internal/entity"
+)
+
+func (s resumeRPC) CreateCoverLetter(ctx context.Context, in *resume_service.CoverLetter) (*resume_service.CoverLetterWithID, error) {
+	letter, err := s.coverLetterUseCase.CreateCoverLetter(ctx, &entity.CoverLetter{
+		ID:        in.Id,
+		UserID:    in.UserId,
+		URL:       in.Url,
+		Template:  in.Template,
+		Recipient: in.Recipient,
+		Company:   in.Company,
+		Date:      in.Date,
+		Content:   in.Content,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &resume_service.CoverLetterWithID{
+		CoverLetterId: letter.ID,
+	}, nil
+}
+
+func (s resumeRPC) DeleteCoverLetter(ctx context.Context, in *resume_service.CoverLetterWithID) (*resume_service.Status, error) {
+	if err := s.coverLetterUseCase.DeleteCoverLetter(ctx, in.CoverLetterId); err != nil {
+		s.logger.Error(err.Error())
+		return &resume_service.Status{Action: false}, err
+	}
+
+	return &resume_service.Status{Action: true}, nil
+}
+
+func (s resumeRPC) GetCoverLetterByID(ctx context.Context, in *resume_service.CoverLetterWithID) (*resume_service.CoverLetter, error) {
+	letter, err := s.coverLetterUseCase.GetCoverLetterByID(ctx, in.CoverLetterId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return coverLetterToProto(letter), nil
+}
+
+func (s resumeRPC) GetUserCoverLetters(ctx context.Context, in *resume_service.UserWithID) (*resume_service.ListCoverLetterResponse, error) {
+	offset := uint64(0)
+	if in.Page > 0 {
+		offset = in.Limit * (in.Page - 1)
+	}
+	letters, err := s.coverLetterUseCase.GetUserCoverLetters(ctx, in.UserId, in.Limit, offset)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	response := &resume_service.ListCoverLetterResponse{}
+	for _, letter := range letters.CoverLetters {
+		response.CoverLetters = append(response.CoverLetters, coverLetterToProto(letter))
+	}
+	response.TotalCount = letters.TotalCount
+
+	return response, nil
+}
+
+func coverLetterToProto(letter *entity.CoverLetter) *resume_service.CoverLetter {
+	return &resume_service.CoverLetter{
+		Id:        letter.ID,
+		UserId:    letter.UserID,
+		Url:       letter.URL,
+		Template:  letter.Template,
+		Recipient: letter.Recipient,
+		Company:   letter.Company,
+		Date:      letter.Date,
+		Content:   letter.Content,
+		CreatedAt: letter.CreatedAt,
+		UpdatedAt: letter.UpdatedAt,
+	}
+}
@@ -10,16 +10,18 @@ import (
 )
 
 type resumeRPC struct {
-	logger        *zap.Logger
-	resumeUseCase usecase.Resume
-	client        grpc_service_clients.ServiceClients
+	logger             *zap.Logger
+	resumeUseCase      usecase.Resume
+	coverLetterUseCase usecase.CoverLetter
+	client             grpc_service_clients.ServiceClients
 }
 
-func NewRPC(logger *zap.Logger, resumeUseCase usecase.Resume, client *grpc_service_clients.ServiceClients) resume_service.ResumeServiceServer {
+func NewRPC(logger *zap.Logger, resumeUseCase usecase.Resume, coverLetterUseCase usecase.CoverLetter, client *grpc_service_clients.ServiceClients) resume_service.ResumeServiceServer {
 	return &resumeRPC{
-		logger:        logger,
-		resumeUseCase: resumeUseCase,
-		client:        *client,
+		logger:             logger,
+		resumeUseCase:      resumeUseCase,
+		coverLetterUseCase: coverLetterUseCase,
+		client:             *client,
 	}
 }
 
@@ -35,6 +37,8 @@ func (s resumeRPC) CreateResume(ctx context.Context, in *resume_service.Resume)
 		JobType:     in.JobType,
 		Experience:  in.Experience,
 		Template:    in.Template,
+		Content:     in.Content,
+		Watermark:   in.Watermark,
 	})
 
 	if err != nil {
@@ -59,6 +63,8 @@ func (s resumeRPC) UpdateResume(ctx context.Context, in *resume_service.Resume)
 		JobType:     in.JobType,
 		Experience:  in.Experience,
 		Template:    in.Template,
+		Content:     in.Content,
+		Watermark:   in.Watermark,
 	})
 	if err != nil {
 		s.logger.Error(err.Error())
@@ -76,6 +82,8 @@ func (s resumeRPC) UpdateResume(ctx context.Context, in *resume_service.Resume)
 		JobType:     resume.JobType,
 		Experience:  resume.Experience,
 		Template:    resume.Template,
+		Content:     resume.Content,
+		Watermark:   resume.Watermark,
 	}, nil
 }
 
@@ -116,6 +124,8 @@ func (s resumeRPC) GetResumeByID(ctx context.Context, in *resume_service.ResumeW
 		JobType:     resume.JobType,
 		Experience:  resume.Experience,
 		Template:    resume.Template,
+		Content:     resume.Content,
+		Watermark:   resume.Watermark,
 	}, nil
 }
 
@@ -142,6 +152,7 @@ func (s resumeRPC) GetUserResume(ctx context.Context, in *resume_service.UserWit
 			JobType:     resume.JobType,
 			Experience:  resume.Experience,
 			Template:    resume.Template,
+			Watermark:   resume.Watermark,
 		})
 	}
 	response.TotalCount = resumes.TotalCount
@@ -180,6 +191,7 @@ func (s resumeRPC) ListResume(ctx context.Context, in *resume_service.ListReques
 			JobType:     resume.JobType,
 			Experience:  resume.Experience,
 			Template:    resume.Template,
+			Watermark:   resume.Watermark,
 		})
 	}
 	response.TotalCount = resumes.TotalCount
@@ -0,0 +1,22 @@
+package entity
+
+type ListCoverLetter struct {
+	CoverLetters []*CoverLetter
+	TotalCount   uint64
+}
+
+// CoverLetter is a letter generated with the basics and the template of a resume.
+type CoverLetter struct {
+	ID        string
+	UserID    string
+	URL       string
+	Template  string
+	Recipient string
+	Company   string
+	// Date is the date written on the letter, YYYY-MM-DD
+	Date string
+	// Content is the JSON of the cover letter the document was generated from
+	Content   string
+	CreatedAt string
+	UpdatedAt string
+}
@@ -16,6 +16,10 @@ type Resume struct {
 	JobType     string
 	Experience  int64
 	Template    string
+	// Content is the JSON of the resume the document was generated from
+	Content string
+	// Watermark is the text printed across a draft, empty for the final document
+	Watermark string
 }
 
 type ListRequest struct {
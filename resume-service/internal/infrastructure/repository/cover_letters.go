@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"github.com/dostonshernazarov/resume_maker/user-service/internal/entity"
+)
+
+type CoverLetters interface {
+	CreateCoverLetter(ctx context.Context, letter *entity.CoverLetter) (*entity.CoverLetter, error)
+	DeleteCoverLetter(ctx context.Context, letterID string) error
+	GetCoverLetterByID(ctx context.Context, letterID string) (*entity.CoverLetter, error)
+	GetUserCoverLetters(ctx context.Context, userID string, limit, offset uint64) (*entity.ListCoverLetter, error)
+}
@@ -0,0 +1,184 @@
+package postgresql
+
+import (
+	"context"
+	"time"
+
+	"github.com/dostonshernazarov/resume_maker/user-service/internal/entity"
+	"github.com/dostonshernazarov/resume_maker/user-service/internal/infrastructure/repository"
+	"github.com/dostonshernazarov/resume_maker/user-service/internal/pkg/postgres"
+	"github.com/jackc/pgx/v4"
+)
+
+const coverLettersTableName = "cover_letters"
+
+const coverLetterColumns = "id, user_id, url, template, recipient, company, date, content, created_at, updated_at"
+
+type coverLetterRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewCoverLetterRepo(db *postgres.PostgresDB) repository.CoverLetters {
+	return &coverLetterRepo{
+		tableName: coverLettersTableName,
+		db:        db,
+	}
+}
+
+func (r coverLetterRepo) CreateCoverLetter(ctx context.Context, letter *entity.CoverLetter) (*entity.CoverLetter, error) {
+	builder := r.db.Sq.Builder.Insert(r.tableName)
+	builder = builder.SetMap(map[string]interface{}{
+		"id":        letter.ID,
+		"user_id":   letter.UserID,
+		"url":       letter.URL,
+		"template":  letter.Template,
+		"recipient": letter.Recipient,
+		"company":   letter.Company,
+		"date":      letter.Date,
+		"content":   letter.Content,
+	})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	commandTag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, pgx.ErrNoRows
+	}
+
+	return letter, nil
+}
+
+func (r coverLetterRepo) DeleteCoverLetter(ctx context.Context, letterID string) error {
+	clauses := map[string]interface{}{
+		"deleted_at": time.Now().Format(time.RFC3339),
+	}
+
+	builder := r.db.Sq.Builder.Update(r.tableName)
+	builder = builder.Where("deleted_at IS NULL")
+	builder = builder.Where(r.db.Sq.Equal("id", letterID))
+	builder = builder.SetMap(clauses)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	commandTag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r coverLetterRepo) GetCoverLetterByID(ctx context.Context, letterID string) (*entity.CoverLetter, error) {
+	builder := r.db.Sq.Builder.Select(coverLetterColumns)
+	builder = builder.From(r.tableName)
+	builder = builder.Where("deleted_at IS NULL")
+	builder = builder.Where(r.db.Sq.Equal("id", letterID))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanCoverLetter(r.db.QueryRow(ctx, query, args...))
+}
+
+// GetUserCoverLetters lists the cover letters of the user, the latest first.
+func (r coverLetterRepo) GetUserCoverLetters(ctx context.Context, userID string, limit, offset uint64) (*entity.ListCoverLetter, error) {
+	var (
+		total    int64
+		response entity.ListCoverLetter
+	)
+
+	builder := r.db.Sq.Builder.Select(coverLetterColumns)
+	builder = builder.From(r.tableName)
+	builder = builder.Where("deleted_at IS NULL")
+	builder = builder.Where(r.db.Sq.Equal("user_id", userID))
+	builder = builder.OrderBy("created_at DESC")
+	if limit > 0 {
+		builder = builder.Limit(limit)
+		builder = builder.Offset(offset)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		letter, err := scanCoverLetter(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		response.CoverLetters = append(response.CoverLetters, letter)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	totalBuilder := r.db.Sq.Builder.Select("COUNT(*)")
+	totalBuilder = totalBuilder.From(r.tableName)
+	totalBuilder = totalBuilder.Where("deleted_at IS NULL")
+	totalBuilder = totalBuilder.Where(r.db.Sq.Equal("user_id", userID))
+
+	totalQuery, args, err := totalBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.db.QueryRow(ctx, totalQuery, args...).Scan(&total); err != nil {
+		return nil, err
+	}
+	response.TotalCount = uint64(total)
+
+	return &response, nil
+}
+
+// scanCoverLetter reads a row selected with coverLetterColumns.
+func scanCoverLetter(row pgx.Row) (*entity.CoverLetter, error) {
+	var (
+		letter               entity.CoverLetter
+		createdAt, updatedAt time.Time
+	)
+
+	err := row.Scan(
+		&letter.ID,
+		&letter.UserID,
+		&letter.URL,
+		&letter.Template,
+		&letter.Recipient,
+		&letter.Company,
+		&letter.Date,
+		&letter.Content,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	letter.CreatedAt = createdAt.Format(time.RFC3339)
+	letter.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+	return &letter, nil
+}
@@ -42,6 +42,8 @@ func (r resumeRepo) CreateResume(ctx context.Context, resume *entity.Resume) (*e
 		"job_type":     resume.JobType,
 		"experience":   resume.Experience,
 		"template":     resume.Template,
+		"content":      resume.Content,
+		"watermark":    resume.Watermark,
 	})
 
 	resumeQuery, resumeArgs, err := resumeBuilder.ToSql()
@@ -75,6 +77,8 @@ func (r resumeRepo) UpdateResume(ctx context.Context, resume *entity.Resume) (*e
 		"job_type":     resume.JobType,
 		"experience":   resume.Experience,
 		"template":     resume.Template,
+		"content":      resume.Content,
+		"watermark":    resume.Watermark,
 		"updated_at":   time.Now().Format(time.RFC3339),
 	})
 
@@ -152,7 +156,7 @@ func (r resumeRepo) DeleteUserResumes(ctx context.Context, userID string) error
 func (r resumeRepo) GetResumeByID(ctx context.Context, resumeID string) (*entity.Resume, error) {
 	var response entity.Resume
 
-	builder := r.db.Sq.Builder.Select("id, user_id, url, salary, job_title, region, job_location, job_type, experience, template")
+	builder := r.db.Sq.Builder.Select("id, user_id, url, salary, job_title, region, job_location, job_type, experience, template, content, watermark")
 	builder = builder.From(resumesTableName)
 	builder = builder.Where("deleted_at IS NULL")
 	builder = builder.Where(r.db.Sq.Equal("id", resumeID))
@@ -173,6 +177,8 @@ func (r resumeRepo) GetResumeByID(ctx context.Context, resumeID string) (*entity
 		&response.JobType,
 		&response.Experience,
 		&response.Template,
+		&response.Content,
+		&response.Watermark,
 	)
 	if err != nil {
 		return nil, err
@@ -231,7 +237,7 @@ func (r resumeRepo) ListResume(ctx context.Context, request *entity.ListRequest)
 	)
 	offset := request.Limit * (request.Page - 1)
 
-	builder := r.db.Sq.Builder.Select("id, user_id, url, salary, job_title, region, job_location, job_type, experience, template")
+	builder := r.db.Sq.Builder.Select("id, user_id, url, salary, job_title, region, job_location, job_type, experience, template, watermark")
 	builder = builder.From(r.resumeTableName)
 	builder = builder.Where("deleted_at IS NULL")
 	builder = builder.Where(r.db.Sq.ILike("job_title", "%"+request.JobTitle+"%"))
@@ -265,6 +271,7 @@ func (r resumeRepo) ListResume(ctx context.Context, request *entity.ListRequest)
 			&resume.JobType,
 			&resume.Experience,
 			&resume.Template,
+			&resume.Watermark,
 		)
 		if err != nil {
 			return nil, err
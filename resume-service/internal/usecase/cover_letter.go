@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"github.com/dostonshernazarov/resume_maker/user-service/internal/entity"
+	"github.com/dostonshernazarov/resume_maker/user-service/internal/infrastructure/repository"
+	"time"
+)
+
+type CoverLetter interface {
+	CreateCoverLetter(ctx context.Context, letter *entity.CoverLetter) (*entity.CoverLetter, error)
+	DeleteCoverLetter(ctx context.Context, letterID string) error
+	GetCoverLetterByID(ctx context.Context, letterID string) (*entity.CoverLetter, error)
+	GetUserCoverLetters(ctx context.Context, userID string, limit, offset uint64) (*entity.ListCoverLetter, error)
+}
+
+type coverLetterService struct {
+	BaseUseCase
+	repo       repository.CoverLetters
+	ctxTimeout time.Duration
+}
+
+func NewCoverLetterService(ctxTimeout time.Duration, repo repository.CoverLetters) CoverLetter {
+	return coverLetterService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (u coverLetterService) CreateCoverLetter(ctx context.Context, letter *entity.CoverLetter) (*entity.CoverLetter, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	return u.repo.CreateCoverLetter(ctx, letter)
+}
+
+func (u coverLetterService) DeleteCoverLetter(ctx context.Context, letterID string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	return u.repo.DeleteCoverLetter(ctx, letterID)
+}
+
+func (u coverLetterService) GetCoverLetterByID(ctx context.Context, letterID string) (*entity.CoverLetter, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	return u.repo.GetCoverLetterByID(ctx, letterID)
+}
+
+func (u coverLetterService) GetUserCoverLetters(ctx context.Context, userID string, limit, offset uint64) (*entity.ListCoverLetter, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	return u.repo.GetUserCoverLetters(ctx, userID, limit, offset)
+}
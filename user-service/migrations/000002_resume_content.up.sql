@@ -0,0 +1 @@
+ALTER TABLE resumes ADD COLUMN content TEXT NOT NULL DEFAULT '';
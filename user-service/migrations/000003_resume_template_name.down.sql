@@ -0,0 +1 @@
+ALTER TABLE resumes ALTER COLUMN template TYPE VARCHAR(20) USING LEFT(template, 20);
@@ -0,0 +1 @@
+ALTER TABLE resumes DROP COLUMN IF EXISTS watermark;
@@ -0,0 +1 @@
+ALTER TABLE resumes ADD COLUMN watermark TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cover_letters;
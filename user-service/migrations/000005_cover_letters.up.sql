@@ -0,0 +1,13 @@
+CREATE TABLE cover_letters (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    url TEXT NOT NULL,
+    template VARCHAR(64) NOT NULL,
+    recipient VARCHAR(100) NOT NULL DEFAULT '',
+    company VARCHAR(100) NOT NULL DEFAULT '',
+    date VARCHAR(10) NOT NULL,
+    content TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);